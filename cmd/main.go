@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net"
@@ -9,18 +11,30 @@ import (
 	"path"
 	"time"
 
+	gcluster "github.com/esaseleznev/taskstoredb/internal/adapters/cluster/grpc"
 	cluster "github.com/esaseleznev/taskstoredb/internal/adapters/cluster/http"
+	"github.com/esaseleznev/taskstoredb/internal/adapters/metrics"
+	"github.com/esaseleznev/taskstoredb/internal/adapters/raftnet"
 	store "github.com/esaseleznev/taskstoredb/internal/adapters/store/leveldb"
+	"github.com/esaseleznev/taskstoredb/internal/adapters/webhook"
 	"github.com/esaseleznev/taskstoredb/internal/app"
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
 	"github.com/esaseleznev/taskstoredb/internal/app/command"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/app/query"
 	"github.com/esaseleznev/taskstoredb/internal/config"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+	"github.com/esaseleznev/taskstoredb/internal/ports/auth"
+	gport "github.com/esaseleznev/taskstoredb/internal/ports/grpc"
 	hport "github.com/esaseleznev/taskstoredb/internal/ports/http"
+	"github.com/esaseleznev/taskstoredb/pkg/taskstorepb"
 	"github.com/hashicorp/raft"
 	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
 	"github.com/justinrixx/retryhttp"
 	"github.com/serialx/hashring"
 	"github.com/syndtr/goleveldb/leveldb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
@@ -30,12 +44,83 @@ func main() {
 		logger.Printf("Could not create config %+v\n", err)
 		return
 	}
-	application, err := newApplication(config)
+	var policy *access.Policy
+	if config.Auth.Policy != "" {
+		if policy, err = access.LoadPolicy(config.Auth.Policy, logger); err != nil {
+			logger.Printf("Could not load access policy %+v\n", err)
+			return
+		}
+	}
+	certs, err := newCerts(config, logger)
+	if err != nil {
+		logger.Printf("Could not load tls certificates %+v\n", err)
+		return
+	}
+	var serverTls *tls.Config
+	if certs != nil {
+		defer certs.Watch(certsReload)()
+		serverTls = certs.ServerConfig(config.Tls.RequireClient)
+	}
+	m := metrics.New()
+	apps, err := newApplication(config, policy, certs, m)
 	if err != nil {
 		logger.Printf("Could not create application %+v\n", err)
 		return
 	}
-	httpServer := hport.NewHttpServer(config.Cluster.CurrentPort, application, logger)
+	authenticator, err := newAuthenticator(config)
+	if err != nil {
+		logger.Printf("Could not create authenticator %+v\n", err)
+		return
+	}
+	if config.Cluster.Transport == "grpc" {
+		var opts []grpc.ServerOption
+		if config.Auth.ClusterToken != "" {
+			opts = gport.Auth(auth.ClusterToken(config.Auth.ClusterToken))
+		}
+		opts = append(opts, gport.Namespace(apps)...)
+		if certs != nil {
+			// only nodes call the cluster port
+			opts = append(opts, grpc.Creds(credentials.NewTLS(certs.ServerConfig(true))))
+		}
+		clusterServer := gport.NewGrpcServer(config.Cluster.GrpcPort, logger, opts...)
+		clusterServer.Register(&clusterpb.Cluster_ServiceDesc, gport.NewClusterServer(apps))
+		startGrpc(clusterServer, logger)
+		defer clusterServer.Stop()
+	}
+	if config.Grpc.Port != "" {
+		var opts []grpc.ServerOption
+		if authenticator != nil {
+			opts = gport.Auth(authenticator)
+		}
+		opts = append(opts, gport.Namespace(apps)...)
+		if serverTls != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(serverTls)))
+		}
+		grpcServer := gport.NewGrpcServer(config.Grpc.Port, logger, opts...)
+		grpcServer.Register(&taskstorepb.TaskStore_ServiceDesc, gport.NewTaskServer(apps))
+		grpcServer.WatchHealth(apps.Default().Commands.HealthCheck.Handle, 10*time.Second)
+		startGrpc(grpcServer, logger)
+		defer grpcServer.Stop()
+	}
+
+	// deliveries are retried with backoff so an error is only logged
+	stopWebhooks := startPeriodic(func(ctx context.Context) error {
+		return apps.Each(ctx, func(ctx context.Context, a app.Application) error {
+			return a.Commands.DeliverWebhooks.Handle(ctx)
+		})
+	}, "Webhook delivery", logger)
+	defer stopWebhooks()
+	stopExpire := startPeriodic(func(ctx context.Context) error {
+		return apps.Each(ctx, func(ctx context.Context, a app.Application) error {
+			return a.Commands.ExpireOwners.Handle(ctx)
+		})
+	}, "Owner expire", logger)
+	defer stopExpire()
+	// quotas are checked with the usage of the other nodes
+	stopUsage := startPeriodic(apps.Default().Queries.Namespaces.Refresh, "Namespace usage", logger)
+	defer stopUsage()
+
+	httpServer := hport.NewHttpServer(config.Cluster.CurrentPort, config.Http.Timeout, apps, logger, authenticator, serverTls, m)
 	err = httpServer.Start()
 	if err != nil {
 		logger.Printf("Http server fatal error %+v\n", err)
@@ -43,121 +128,339 @@ func main() {
 	}
 }
 
-func newApplication( /*ctx context.Context,*/ config config.Config) (a app.Application, err error) {
+// certsReload is how often changed certificate files are loaded again
+const certsReload = 10 * time.Second
+
+// newCerts loads the tls certificates of c, nil without tls.
+func newCerts(c config.Config, logger *log.Logger) (*config.Certs, error) {
+	if c.Tls.Cert == "" {
+		return nil, nil
+	}
+	return config.NewCerts(c.Tls.Cert, c.Tls.Key, c.Tls.Ca, logger)
+}
+
+// newAuthenticator accepts api keys, jwt and the cluster token of the config,
+// nil when none is configured.
+func newAuthenticator(config config.Config) (auth.Authenticator, error) {
+	var chain auth.Chain
+	if len(config.Auth.Keys) > 0 {
+		keys, err := auth.NewKeys(config.Auth.Keys)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, keys)
+	}
+	if config.Auth.JwtSecret != "" {
+		jwt, err := auth.NewJwt(config.Auth.JwtSecret)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, jwt)
+	}
+	if config.Auth.ClusterToken != "" {
+		chain = append(chain, auth.ClusterToken(config.Auth.ClusterToken))
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	return chain, nil
+}
+
+func startGrpc(server gport.GrpcServer, logger *log.Logger) {
+	go func() {
+		if err := server.Start(); err != nil {
+			logger.Printf("Grpc server fatal error %+v\n", err)
+		}
+	}()
+}
+
+// startPeriodic runs handle every second until stop is called.
+func startPeriodic(handle func(ctx context.Context) error, name string, logger *log.Logger) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := handle(ctx); err != nil && ctx.Err() == nil {
+					logger.Printf("%s error %+v\n", name, err)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+func newApplication( /*ctx context.Context,*/ config config.Config, policy *access.Policy, certs *config.Certs, m *metrics.Metrics) (apps *app.Namespaces, err error) {
 	level, err := leveldb.OpenFile(config.Db.Path, nil)
 	if err != nil {
-		return a, fmt.Errorf("Could not open leveldb %+v\n", err)
+		return nil, fmt.Errorf("Could not open leveldb %+v\n", err)
 	}
 
 	db, err := store.NewLevelAdapter(level)
 	if err != nil {
-		return a, fmt.Errorf("Could not create level adapter %+v\n", err)
+		return nil, fmt.Errorf("Could not create level adapter %+v\n", err)
 	}
 
-	httpClient := &http.Client{
-		Transport: retryhttp.New(
-			// optional retry configurations
-			retryhttp.WithShouldRetryFn(func(attempt retryhttp.Attempt) bool {
-				return attempt.Res != nil && attempt.Res.StatusCode == http.StatusServiceUnavailable
-			}),
-			retryhttp.WithDelayFn(func(attempt retryhttp.Attempt) time.Duration {
-				return time.Duration(attempt.Count*3) * time.Second
-			}),
-			retryhttp.WithMaxRetries(3),
-		),
-		// other HTTP client options
+	cluster, err := newCluster(config, certs, m)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create cluster adapter %+v\n", err)
 	}
-	cluster := cluster.NewHttpClusterAdapter(httpClient)
 
 	servers := config.Cluster.Servers
-	ring := hashring.New(servers)
+	n := node{
+		config:  config,
+		policy:  policy,
+		cluster: cluster,
+		ring:    hashring.New(servers),
+		fan:     fanout.NewExecutor(config.Cluster.Timeout),
+	}
+
+	n.raft, err = newRaft(&config, (*store.Fsm)(db), certs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raft: %v", err)
+	}
+	n.committer = command.NewCommitter(n.raft, config.Raft.CommitWindow, config.Raft.CommitSize)
+	n.committer.Observe(m.ObserveApply)
+	err = m.Register(metrics.RaftCollector(n.raft), metrics.LevelDbCollector(level), metrics.TasksCollector(db))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register metrics: %v", err)
+	}
+
+	// namespaces are managed in the root keyspace whatever namespace is called
+	n.namespaceCreate, err = command.NewNamespaceCreateHandler(db, cluster, config.Cluster.Current, servers, n.committer, n.fan, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create namespace create handler: %v", err)
+	}
+
+	n.namespaceDrop, err = command.NewNamespaceDropHandler(db, cluster, config.Cluster.Current, servers, n.committer, n.fan, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create namespace drop handler: %v", err)
+	}
 
-	raft, err := newRaft(&config, (*store.Fsm)(db))
+	n.namespaces, err = query.NewNamespacesHandler(db, cluster, config.Cluster.Current, servers, n.fan, policy)
 	if err != nil {
-		return a, fmt.Errorf("failed to create raft: %v", err)
+		return nil, fmt.Errorf("failed to create namespaces handler: %v", err)
 	}
 
-	addTask, err := command.NewAddTaskHandler(db, cluster, ring, config.Cluster.Current, raft)
+	def, err := n.application(db)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewNamespaces(def, db, func(ns string) (a app.Application, err error) {
+		view, err := db.Namespace(ns)
+		if err != nil {
+			return a, err
+		}
+		return n.application(view)
+	})
+}
+
+// node is what the applications of all namespaces of the node share.
+type node struct {
+	config          config.Config
+	policy          *access.Policy
+	cluster         clusterAdapter
+	ring            *hashring.HashRing
+	fan             fanout.Executor
+	raft            *raft.Raft
+	committer       *command.Committer
+	namespaceCreate command.NamespaceCreateHandler
+	namespaceDrop   command.NamespaceDropHandler
+	namespaces      query.NamespacesHandler
+}
+
+// application builds the handlers of the namespace of db.
+func (n node) application(db *store.LevelAdapter) (a app.Application, err error) {
+	config, policy, cluster, ring, fan := n.config, n.policy, n.cluster, n.ring, n.fan
+	raft, committer := n.raft, n.committer
+	servers := config.Cluster.Servers
+
+	addTask, err := command.NewAddTaskHandler(db, cluster, ring, config.Cluster.Current, committer, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create add task handler: %v", err)
 	}
 
-	updateTask, err := command.NewUpdateTaskHandler(db, cluster, ring, config.Cluster.Current, raft)
+	updateTask, err := command.NewUpdateTaskHandler(db, cluster, ring, config.Cluster.Current, committer, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create update task handler: %v", err)
 	}
 
-	ownerReg, err := command.NewOwnerRegHandler(db, cluster, ring, config.Cluster.Current, servers, raft)
+	addTaskBatch, err := command.NewAddTaskBatchHandler(db, cluster, ring, config.Cluster.Current, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create add task batch handler: %v", err)
+	}
+
+	updateTaskBatch, err := command.NewUpdateTaskBatchHandler(db, cluster, ring, config.Cluster.Current, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create update task batch handler: %v", err)
+	}
+
+	ownerReg, err := command.NewOwnerRegHandler(db, cluster, ring, config.Cluster.Current, servers, committer, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create owner registration handler: %v", err)
 	}
 
-	ownerUnReg, err := command.NewOwnerUnRegHandler(db, cluster, ring, config.Cluster.Current, servers, raft)
+	ownerUnReg, err := command.NewOwnerUnRegHandler(db, cluster, ring, config.Cluster.Current, servers, committer, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create owner unregistration handler: %v", err)
 	}
 
-	searchDeleteTask, err := command.NewSearchDeleteTaskHandler(db, cluster, ring, config.Cluster.Current, servers, raft)
+	ownerHeartbeat, err := command.NewOwnerHeartbeatHandler(db, cluster, config.Cluster.Current, servers, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create owner heartbeat handler: %v", err)
+	}
+
+	expireOwners, err := command.NewExpireOwnersHandler(db, committer)
+	if err != nil {
+		return a, fmt.Errorf("failed to create expire owners handler: %v", err)
+	}
+
+	searchDeleteTask, err := command.NewSearchDeleteTaskHandler(db, cluster, ring, config.Cluster.Current, servers, committer, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create search delete task handler: %v", err)
 	}
 
-	searchDeleteErrorTask, err := command.NewSearchDeleteErrorTaskHandler(db, cluster, ring, config.Cluster.Current, servers, raft)
+	searchDeleteErrorTask, err := command.NewSearchDeleteErrorTaskHandler(db, cluster, ring, config.Cluster.Current, servers, committer, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create search delete error task handler: %v", err)
 	}
 
-	searchUpdateTask, err := command.NewSearchUpdateTaskHandler(db, cluster, ring, config.Cluster.Current, servers, raft)
+	searchUpdateTask, err := command.NewSearchUpdateTaskHandler(db, cluster, ring, config.Cluster.Current, servers, committer, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create search update task handler: %v", err)
 	}
 
-	searchUpdateErrorTask, err := command.NewSearchUpdateErrorTaskHandler(db, cluster, ring, config.Cluster.Current, servers, raft)
+	searchUpdateErrorTask, err := command.NewSearchUpdateErrorTaskHandler(db, cluster, ring, config.Cluster.Current, servers, committer, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create search update error task handler: %v", err)
 	}
 
-	healthCheck, err := command.NewHealthCheckHandler(db, raft)
+	healthCheck, err := command.NewHealthCheckHandler(db, committer)
 	if err != nil {
 		return a, fmt.Errorf("failed to create health check handler: %v", err)
 	}
 
-	getFirstInGroup, err := query.NewGetFirstInGroupHandler(db, cluster, ring, config.Cluster.Current)
+	getFirstInGroup, err := query.NewGetFirstInGroupHandler(db, cluster, ring, config.Cluster.Current, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create get first in group handler: %v", err)
 	}
 
-	pool, err := query.NewPoolHandler(db, cluster, ring, config.Cluster.Current, servers)
+	inFlight, err := query.NewInFlightHandler(db, cluster, config.Cluster.Current, servers, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create in flight handler: %v", err)
+	}
+
+	claimTasks, err := command.NewClaimTasksHandler(db, committer)
+	if err != nil {
+		return a, fmt.Errorf("failed to create claim tasks handler: %v", err)
+	}
+
+	pool, err := query.NewPoolHandler(db, cluster, ring, config.Cluster.Current, servers, fan, inFlight, updateTaskBatch, claimTasks, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create pool handler: %v", err)
 	}
 
-	get, err := query.NewGetHandler(db, cluster, ring, config.Cluster.Current)
+	get, err := query.NewGetHandler(db, cluster, ring, config.Cluster.Current, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create get handler: %v", err)
 	}
 
-	searchTask, err := query.NewSearchTaskHandler(db, cluster, ring, config.Cluster.Current, servers)
+	searchTask, err := query.NewSearchTaskHandler(db, cluster, ring, config.Cluster.Current, servers, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create search task handler: %v", err)
 	}
 
-	searchError, err := query.NewSearchErrorTaskHandler(db, cluster, ring, config.Cluster.Current, servers)
+	searchError, err := query.NewSearchErrorTaskHandler(db, cluster, ring, config.Cluster.Current, servers, fan, policy)
 	if err != nil {
 		return a, fmt.Errorf("failed to create search error task handler: %v", err)
 	}
 
+	events, err := query.NewEventsHandler(db, cluster, config.Cluster.Current, servers, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create events handler: %v", err)
+	}
+
+	webhookReg, err := command.NewWebhookRegHandler(db, cluster, config.Cluster.Current, servers, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create webhook registration handler: %v", err)
+	}
+
+	webhookUnReg, err := command.NewWebhookUnRegHandler(db, cluster, config.Cluster.Current, servers, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create webhook unregistration handler: %v", err)
+	}
+
+	deliverWebhooks, err := command.NewDeliverWebhooksHandler(db, webhook.NewHttpSender(config.Cluster.Timeout), committer)
+	if err != nil {
+		return a, fmt.Errorf("failed to create deliver webhooks handler: %v", err)
+	}
+
+	webhooks, err := query.NewWebhooksHandler(db, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create webhooks handler: %v", err)
+	}
+
+	pause, err := command.NewPauseHandler(db, cluster, config.Cluster.Current, servers, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create pause handler: %v", err)
+	}
+
+	paused, err := query.NewPausedHandler(db, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create paused handler: %v", err)
+	}
+
+	kindConfig, err := command.NewKindConfigHandler(db, cluster, config.Cluster.Current, servers, committer, fan, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create kind config handler: %v", err)
+	}
+
+	kindConfigs, err := query.NewKindConfigsHandler(db, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create kind configs handler: %v", err)
+	}
+
+	owners, err := query.NewOwnersHandler(db, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create owners handler: %v", err)
+	}
+
+	kindOwners, err := query.NewKindOwnersHandler(db, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create kind owners handler: %v", err)
+	}
+
+	status, err := query.NewStatusHandler(raft, config.Cluster.Current, servers, policy)
+	if err != nil {
+		return a, fmt.Errorf("failed to create status handler: %v", err)
+	}
+
 	return app.Application{
 		Commands: app.Commands{
 			AddTask:               addTask,
 			UpdateTask:            updateTask,
+			AddTaskBatch:          addTaskBatch,
+			UpdateTaskBatch:       updateTaskBatch,
 			OwnerReg:              ownerReg,
+			OwnerHeartbeat:        ownerHeartbeat,
+			ExpireOwners:          expireOwners,
 			OwnerUnReg:            ownerUnReg,
 			SearchDeleteTask:      searchDeleteTask,
 			SearchDeleteErrorTask: searchDeleteErrorTask,
 			SearchUpdateTask:      searchUpdateTask,
 			SearchUpdateErrorTask: searchUpdateErrorTask,
 			HealthCheck:           healthCheck,
+			WebhookReg:            webhookReg,
+			WebhookUnReg:          webhookUnReg,
+			DeliverWebhooks:       deliverWebhooks,
+			Pause:                 pause,
+			KindConfig:            kindConfig,
+			NamespaceCreate:       n.namespaceCreate,
+			NamespaceDrop:         n.namespaceDrop,
 		},
 		Queries: app.Queries{
 			GetFirstInGroup: getFirstInGroup,
@@ -165,11 +468,87 @@ func newApplication( /*ctx context.Context,*/ config config.Config) (a app.Appli
 			Pool:            pool,
 			SearchTask:      searchTask,
 			SearchError:     searchError,
+			Events:          events,
+			Webhooks:        webhooks,
+			Owners:          owners,
+			KindOwners:      kindOwners,
+			Status:          status,
+			Paused:          paused,
+			KindConfigs:     kindConfigs,
+			InFlight:        inFlight,
+			Namespaces:      n.namespaces,
 		},
 	}, nil
 }
 
-func newRaft(config *config.Config, fsm *store.Fsm) (*raft.Raft, error) {
+type clusterAdapter interface {
+	command.AddTaskClusterAdapter
+	command.UpdateTaskClusterAdapter
+	command.AddTaskBatchClusterAdapter
+	command.UpdateTaskBatchClusterAdapter
+	command.OwnerRegClusterAdapter
+	command.OwnerUnRegClusterAdapter
+	command.OwnerHeartbeatClusterAdapter
+	command.SearchDeleteTaskClusterAdapter
+	command.SearchDeleteErrorTaskClusterAdapter
+	command.SearchUpdateTaskClusterAdapter
+	command.SearchUpdateErrorTaskClusterAdapter
+	command.WebhookRegClusterAdapter
+	command.WebhookUnRegClusterAdapter
+	command.PauseClusterAdapter
+	command.KindConfigClusterAdapter
+	query.GetFirstInGroupClusterAdapter
+	query.PoolClusterAdapter
+	query.InFlightClusterAdapter
+	query.GetClusterAdapter
+	query.SearchTaskClusterAdapter
+	query.SearchErrorTaskClusterAdapter
+	query.EventsClusterAdapter
+	command.NamespaceCreateClusterAdapter
+	command.NamespaceDropClusterAdapter
+	query.NamespacesClusterAdapter
+}
+
+func newCluster(config config.Config, certs *config.Certs, m *metrics.Metrics) (clusterAdapter, error) {
+	if config.Cluster.Transport == "grpc" {
+		opts := m.DialOptions()
+		if config.Auth.ClusterToken != "" {
+			opts = append(opts, gcluster.WithToken(config.Auth.ClusterToken))
+		}
+		if certs != nil {
+			opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(certs.ClientConfig())))
+		}
+		grpcCluster, err := gcluster.NewGrpcClusterAdapter(config.Cluster.GrpcServers, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return grpcCluster, nil
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if certs != nil {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = certs.ClientConfig()
+		transport = t
+	}
+	httpClient := &http.Client{
+		Transport: retryhttp.New(
+			retryhttp.WithTransport(transport),
+			// optional retry configurations
+			retryhttp.WithShouldRetryFn(func(attempt retryhttp.Attempt) bool {
+				return attempt.Res != nil && attempt.Res.StatusCode == http.StatusServiceUnavailable
+			}),
+			retryhttp.WithDelayFn(func(attempt retryhttp.Attempt) time.Duration {
+				return time.Duration(attempt.Count*3) * time.Second
+			}),
+			retryhttp.WithMaxRetries(3),
+		),
+		// other HTTP client options
+	}
+	return cluster.NewHttpClusterAdapter(httpClient, config.Auth.ClusterToken, m.ObserveCall), nil
+}
+
+func newRaft(config *config.Config, fsm *store.Fsm, certs *config.Certs) (*raft.Raft, error) {
 	os.MkdirAll(config.Raft.Path, os.ModePerm)
 
 	store, err := raftboltdb.NewBoltStore(path.Join(config.Raft.Path, "bolt"))
@@ -187,15 +566,25 @@ func newRaft(config *config.Config, fsm *store.Fsm) (*raft.Raft, error) {
 		return nil, fmt.Errorf("Could not resolve address: %s", err)
 	}
 
-	transport, err := raft.NewTCPTransport(
-		config.Raft.Current.Address,
-		tcpAddr,
-		10,
-		time.Second*10,
-		os.Stderr,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Could not create tcp transport: %s", err)
+	var transport *raft.NetworkTransport
+	if certs != nil {
+		// raft peers are nodes, each side verifies the other
+		stream, err := raftnet.NewTlsStreamLayer(config.Raft.Current.Address, tcpAddr, certs.ServerConfig(true), certs.ClientConfig())
+		if err != nil {
+			return nil, fmt.Errorf("Could not create tls stream layer: %s", err)
+		}
+		transport = raft.NewNetworkTransport(stream, 10, time.Second*10, os.Stderr)
+	} else {
+		transport, err = raft.NewTCPTransport(
+			config.Raft.Current.Address,
+			tcpAddr,
+			10,
+			time.Second*10,
+			os.Stderr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create tcp transport: %s", err)
+		}
 	}
 
 	raftCfg := raft.DefaultConfig()
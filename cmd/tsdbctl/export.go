@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+const importBatchSize = 1000
+
+func exportCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("export")
+	f := filter{}
+	addFilterFlags(fs, &f)
+	file := fs.String("file", "-", "file to write, - for stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	r, err := f.request()
+	if err != nil {
+		return err
+	}
+
+	var tasks []client.Task
+	if f.errors {
+		tasks, err = c.SearchError(ctx, r)
+	} else {
+		tasks, err = c.SearchTask(ctx, r)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := out.w
+	if *file != "-" {
+		fw, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer fw.Close()
+		w = fw
+	}
+	bw := bufio.NewWriter(w)
+	e := json.NewEncoder(bw)
+	for _, t := range tasks {
+		if err = e.Encode(t); err != nil {
+			return err
+		}
+	}
+	if err = bw.Flush(); err != nil {
+		return err
+	}
+	if *file != "-" {
+		return out.message("exported %d tasks", len(tasks))
+	}
+	return nil
+}
+
+// importCmd adds exported tasks as new ones, the ids and statuses
+// of the export are not kept.
+func importCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("import")
+	file := fs.String("file", "-", "file to read, - for stdin")
+	keepOwner := fs.Bool("keep-owner", false, "keep the owners of the exported tasks")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if *file != "-" {
+		fr, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer fr.Close()
+		r = fr
+	}
+
+	added, failed := 0, 0
+	batch := make([]client.AddRequest, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		items, err := c.AddBatch(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if item.Error != nil {
+				failed++
+				fmt.Fprintln(os.Stderr, "tsdbctl: import error:", *item.Error)
+			} else {
+				added++
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	d := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var t client.Task
+		err := d.Decode(&t)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("decode task error: %v", err)
+		}
+		a := client.AddRequest{Group: t.Group, Kind: t.Kind, Param: t.Param}
+		if *keepOwner {
+			a.Owner = t.Owner
+		}
+		batch = append(batch, a)
+		if len(batch) == importBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("imported %d tasks, %d failed", added, failed)
+	}
+	return out.message("imported %d tasks", added)
+}
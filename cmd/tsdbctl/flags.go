@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+// params collects repeated -param key=value flags.
+type params map[string]string
+
+func (p params) String() string {
+	return fmt.Sprint(map[string]string(p))
+}
+
+func (p params) Set(v string) error {
+	key, value, ok := strings.Cut(v, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("param %q is not key=value", v)
+	}
+	p[key] = value
+	return nil
+}
+
+func newFlags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+func parseStatus(v string) (client.Status, error) {
+	switch strings.ToLower(v) {
+	case "virgin":
+		return client.VIRGIN, nil
+	case "scheduled":
+		return client.SCHEDULED, nil
+	case "completed":
+		return client.COMPLETED, nil
+	case "failed":
+		return client.FAILED, nil
+	case "cancelled":
+		return client.CANCELLED, nil
+	case "paused":
+		return client.PAUSED, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < int(client.VIRGIN) || n > int(client.PAUSED) {
+		return 0, fmt.Errorf("unknown status %q", v)
+	}
+	return client.Status(n), nil
+}
+
+func statusName(s client.Status) string {
+	switch s {
+	case client.VIRGIN:
+		return "VIRGIN"
+	case client.SCHEDULED:
+		return "SCHEDULED"
+	case client.COMPLETED:
+		return "COMPLETED"
+	case client.FAILED:
+		return "FAILED"
+	case client.CANCELLED:
+		return "CANCELLED"
+	case client.PAUSED:
+		return "PAUSED"
+	}
+	return strconv.Itoa(int(s))
+}
+
+// filter is the search condition built from flags, the fields are ANDed
+// with an optional raw json condition.
+type filter struct {
+	kind      string
+	group     string
+	owner     string
+	status    string
+	condition string
+	size      uint
+	errors    bool
+}
+
+func addFilterFlags(fs *flag.FlagSet, f *filter) {
+	fs.StringVar(&f.kind, "kind", "", "kind of tasks")
+	fs.StringVar(&f.group, "group", "", "group of tasks")
+	fs.StringVar(&f.owner, "owner", "", "owner of tasks")
+	fs.StringVar(&f.status, "status", "", "status of tasks")
+	fs.StringVar(&f.condition, "c", "", "json condition, e.g. '{\"ops\":[{\"op\":\">\",\"fld\":\"param.n\",\"val\":\"5\"}]}'")
+	fs.UintVar(&f.size, "size", 0, "max count of tasks per node")
+	fs.BoolVar(&f.errors, "errors", false, "error tasks instead of tasks")
+}
+
+func (f filter) empty() bool {
+	return f.kind == "" && f.group == "" && f.owner == "" && f.status == "" && f.condition == ""
+}
+
+func (f filter) request() (r client.SearchTaskRequest, err error) {
+	and := client.And
+	condition := client.Condition{LogicalOperator: &and}
+	if f.kind != "" {
+		r.Kind = &f.kind
+	}
+	if f.group != "" {
+		condition.Operations = append(condition.Operations, client.Operation{Operator: client.Equal, Field: "group", Value: f.group})
+	}
+	if f.owner != "" {
+		condition.Operations = append(condition.Operations, client.Operation{Operator: client.Equal, Field: "owner", Value: f.owner})
+	}
+	if f.status != "" {
+		s, err := parseStatus(f.status)
+		if err != nil {
+			return r, err
+		}
+		condition.Operations = append(condition.Operations, client.Operation{Operator: client.Equal, Field: "status", Value: strconv.Itoa(int(s))})
+	}
+	if f.condition != "" {
+		nested := client.Condition{}
+		if err = json.Unmarshal([]byte(f.condition), &nested); err != nil {
+			return r, fmt.Errorf("condition is not valid json: %v", err)
+		}
+		condition.Conditions = append(condition.Conditions, nested)
+	}
+	if len(condition.Operations) > 0 || len(condition.Conditions) > 0 {
+		r.Condition = &condition
+	}
+	if f.size > 0 {
+		r.Size = &f.size
+	}
+	return r, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+func TestFilter_Request(t *testing.T) {
+	f := filter{kind: "A", owner: "w1", status: "completed", condition: `{"ops":[{"op":">","fld":"param.n","val":"5"}]}`}
+	r, err := f.request()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Kind == nil || *r.Kind != "A" {
+		t.Errorf("not correct kind")
+	}
+	if r.Condition == nil || len(r.Condition.Operations) != 2 || len(r.Condition.Conditions) != 1 {
+		t.Fatalf("not correct condition %+v", r.Condition)
+	}
+	if r.Condition.Operations[1].Value != "3" {
+		t.Errorf("not correct status operation %+v", r.Condition.Operations[1])
+	}
+	if op := r.Condition.Conditions[0].Operations[0]; op.Operator != client.GreaterThan || op.Field != "param.n" {
+		t.Errorf("not correct nested condition %+v", op)
+	}
+
+	if r, _ = (filter{}).request(); r.Condition != nil || r.Kind != nil {
+		t.Errorf("not correct empty filter")
+	}
+	if _, err = (filter{status: "unknown"}).request(); err == nil {
+		t.Errorf("not correct unknown status")
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+func kindCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	if len(args) == 0 {
+		return errors.New("kind command set, list, owners or inflight is required")
+	}
+	switch args[0] {
+	case "set":
+		return kindSetCmd(ctx, c, out, args[1:])
+	case "list":
+		return kindListCmd(ctx, c, out, args[1:])
+	case "owners":
+		return kindOwnersCmd(ctx, c, out, args[1:])
+	case "inflight":
+		return kindInFlightCmd(ctx, c, out, args[1:])
+	}
+	return fmt.Errorf("unknown kind command %q", args[0])
+}
+
+func kindSetCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("kind set")
+	kind := fs.String("kind", "", "kind to configure")
+	ordered := fs.Bool("ordered", false, "pool only the head task of each group")
+	maxInFlight := fs.Int("max-in-flight", 0, "max count of scheduled tasks in the cluster, 0 is unlimited")
+	rate := fs.Float64("rate", 0, "max count of tasks scheduled per second, 0 is unlimited")
+	strategy := fs.String("strategy", "", "owner assignment: round_robin, weighted, least_outstanding, hash or unassigned")
+	hashParam := fs.String("hash-param", "", "param of the task the hash strategy assigns by")
+	steal := fs.Bool("steal", false, "idle owners take unstarted tasks of the busiest owner")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *kind == "" {
+		return errors.New("kind is required")
+	}
+
+	config := client.KindConfig{
+		Kind:        *kind,
+		Ordered:     *ordered,
+		MaxInFlight: *maxInFlight,
+		Rate:        *rate,
+		Strategy:    client.Strategy(*strategy),
+		HashParam:   *hashParam,
+		Steal:       *steal,
+	}
+	if err := c.KindConfigSet(ctx, config); err != nil {
+		return err
+	}
+	return out.message("configured %s", *kind)
+}
+
+func kindListCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("kind list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	configs, err := c.KindConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(configs))
+	for _, k := range configs {
+		rows = append(rows, []string{
+			k.Kind,
+			strconv.FormatBool(k.Ordered),
+			strconv.Itoa(k.MaxInFlight),
+			strconv.FormatFloat(k.Rate, 'f', -1, 64),
+			strategyName(k.Strategy),
+			k.HashParam,
+			strconv.FormatBool(k.Steal),
+		})
+	}
+	return out.table(configs, []string{"KIND", "ORDERED", "MAX IN FLIGHT", "RATE", "STRATEGY", "HASH PARAM", "STEAL"}, rows)
+}
+
+func kindOwnersCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("kind owners")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	kinds, err := c.KindOwners(ctx)
+	if err != nil {
+		return err
+	}
+	if kinds == nil {
+		kinds = []client.KindOwners{}
+	}
+	rows := make([][]string, 0, len(kinds))
+	for _, k := range kinds {
+		owners := make([]string, 0, len(k.Owners))
+		for i, owner := range k.Owners {
+			if i < len(k.Weights) && k.Weights[i] > 1 {
+				owner += ":" + strconv.Itoa(k.Weights[i])
+			}
+			owners = append(owners, owner)
+		}
+		rows = append(rows, []string{k.Kind, strategyName(k.Strategy), strings.Join(owners, ",")})
+	}
+	return out.table(kinds, []string{"KIND", "STRATEGY", "OWNERS"}, rows)
+}
+
+func strategyName(s client.Strategy) string {
+	if s == "" {
+		return string(client.ROUND_ROBIN)
+	}
+	return string(s)
+}
+
+func kindInFlightCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("kind inflight")
+	kind := fs.String("kind", "", "kind of tasks")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *kind == "" {
+		return errors.New("kind is required")
+	}
+
+	n, err := c.InFlight(ctx, *kind)
+	if err != nil {
+		return err
+	}
+	if out.json {
+		return out.encode(map[string]int{"inflight": n})
+	}
+	return out.message("%d", n)
+}
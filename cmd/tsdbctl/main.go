@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+const usage = `tsdbctl is the admin tool of taskstoredb.
+
+Usage:
+  tsdbctl [-url urls] [-token t] [-ns n] [-cacert f] [-format table|json] [-timeout d] <command> [flags]
+
+Commands:
+  add            add a task
+  get            get a task
+  update         update the status of a task
+  search         search tasks or error tasks
+  search-delete  delete tasks matching filters
+  search-update  update tasks matching filters
+  owner          reg, unreg or list owners
+  pool           show the pool of an owner
+  pause          pause a kind or a group, or list paused ones
+  resume         resume a kind or a group
+  kind           set or list kind configs
+  status         show cluster nodes and their raft state
+  namespace      create, list or drop namespaces
+  export         write tasks as json lines
+  import         add tasks from json lines
+
+Run 'tsdbctl <command> -h' for the flags of a command.
+`
+
+type command func(ctx context.Context, c *client.Client, out output, args []string) error
+
+var commands = map[string]command{
+	"add":           addCmd,
+	"get":           getCmd,
+	"update":        updateCmd,
+	"search":        searchCmd,
+	"search-delete": searchDeleteCmd,
+	"search-update": searchUpdateCmd,
+	"owner":         ownerCmd,
+	"pool":          poolCmd,
+	"pause":         pauseCmd,
+	"resume":        resumeCmd,
+	"kind":          kindCmd,
+	"status":        statusCmd,
+	"namespace":     namespaceCmd,
+	"export":        exportCmd,
+	"import":        importCmd,
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "tsdbctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("tsdbctl", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), usage) }
+	defaultUrl := os.Getenv("TSDB_URL")
+	if defaultUrl == "" {
+		defaultUrl = "http://localhost:8080"
+	}
+	urls := fs.String("url", defaultUrl, "comma separated node urls, env TSDB_URL")
+	token := fs.String("token", os.Getenv("TSDB_TOKEN"), "api key or jwt, env TSDB_TOKEN")
+	ns := fs.String("ns", os.Getenv("TSDB_NAMESPACE"), "namespace of the tasks, env TSDB_NAMESPACE")
+	cacert := fs.String("cacert", os.Getenv("TSDB_CACERT"), "ca file verifying the nodes, env TSDB_CACERT")
+	cert := fs.String("cert", os.Getenv("TSDB_CERT"), "client certificate file, env TSDB_CERT")
+	key := fs.String("key", os.Getenv("TSDB_KEY"), "client key file, env TSDB_KEY")
+	format := fs.String("format", "table", "output format table or json")
+	timeout := fs.Duration("timeout", time.Minute, "timeout of the command")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("command is required")
+	}
+
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown command %q", fs.Arg(0))
+	}
+	out, err := newOutput(*format, os.Stdout)
+	if err != nil {
+		return err
+	}
+	opts := []client.Option{client.WithToken(*token), client.WithNamespace(*ns)}
+	if *cacert != "" || *cert != "" {
+		h, err := tlsClient(*cacert, *cert, *key)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithHttpClient(h))
+	}
+	c, err := client.New(strings.Split(*urls, ","), opts...)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	err = cmd(ctx, c, out, fs.Args()[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	return err
+}
+
+// tlsClient verifies the nodes by cacert, or the system roots, and presents
+// the client certificate if any.
+func tlsClient(cacert string, cert string, key string) (*http.Client, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cacert != "" {
+		pem, err := os.ReadFile(cacert)
+		if err != nil {
+			return nil, fmt.Errorf("read ca error: %v", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in ca %v", cacert)
+		}
+	}
+	if cert != "" {
+		pair, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate error: %v", err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+	return &http.Client{Timeout: client.DefaultTimeout, Transport: transport}, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+func namespaceCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	if len(args) == 0 {
+		return errors.New("namespace command create, list or drop is required")
+	}
+	switch args[0] {
+	case "create":
+		return namespaceCreateCmd(ctx, c, out, args[1:])
+	case "list":
+		return namespaceListCmd(ctx, c, out, args[1:])
+	case "drop":
+		return namespaceDropCmd(ctx, c, out, args[1:])
+	}
+	return fmt.Errorf("unknown namespace command %q", args[0])
+}
+
+func namespaceCreateCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("namespace create")
+	name := fs.String("name", "", "namespace to create or change")
+	maxTasks := fs.Int64("max-tasks", 0, "max count of tasks in the cluster, 0 is unlimited")
+	maxBytes := fs.Int64("max-bytes", 0, "max size of tasks in the cluster, 0 is unlimited")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return errors.New("name is required")
+	}
+
+	ns := client.Namespace{Name: *name, MaxTasks: *maxTasks, MaxBytes: *maxBytes}
+	if err := c.NamespaceCreate(ctx, ns); err != nil {
+		return err
+	}
+	return out.message("created %s", *name)
+}
+
+func namespaceListCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("namespace list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	namespaces, err := c.Namespaces(ctx)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		rows = append(rows, []string{
+			ns.Name,
+			strconv.FormatInt(ns.Usage.Tasks, 10),
+			strconv.FormatInt(ns.MaxTasks, 10),
+			strconv.FormatInt(ns.Usage.Bytes, 10),
+			strconv.FormatInt(ns.MaxBytes, 10),
+		})
+	}
+	return out.table(namespaces, []string{"NAME", "TASKS", "MAX TASKS", "BYTES", "MAX BYTES"}, rows)
+}
+
+func namespaceDropCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("namespace drop")
+	name := fs.String("name", "", "namespace to drop with all its tasks")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return errors.New("name is required")
+	}
+
+	if err := c.NamespaceDrop(ctx, *name); err != nil {
+		return err
+	}
+	return out.message("dropped %s", *name)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+type output struct {
+	json bool
+	w    io.Writer
+}
+
+func newOutput(format string, w io.Writer) (output, error) {
+	switch format {
+	case "table":
+		return output{w: w}, nil
+	case "json":
+		return output{json: true, w: w}, nil
+	}
+	return output{}, fmt.Errorf("unknown format %q", format)
+}
+
+func (o output) encode(v any) error {
+	e := json.NewEncoder(o.w)
+	e.SetIndent("", "  ")
+	return e.Encode(v)
+}
+
+// table prints rows under header, or v as json.
+func (o output) table(v any, header []string, rows [][]string) error {
+	if o.json {
+		return o.encode(v)
+	}
+	tw := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (o output) tasks(tasks []client.Task) error {
+	if tasks == nil {
+		tasks = []client.Task{}
+	}
+	rows := make([][]string, 0, len(tasks))
+	for _, t := range tasks {
+		rows = append(rows, []string{
+			t.Id,
+			t.Kind,
+			t.Group,
+			deref(t.Owner),
+			statusName(t.Status),
+			t.Ts.Format(time.RFC3339),
+			formatParams(t.Param),
+			deref(t.Error),
+		})
+	}
+	return o.table(tasks, []string{"ID", "KIND", "GROUP", "OWNER", "STATUS", "TS", "PARAMS", "ERROR"}, rows)
+}
+
+func (o output) message(format string, args ...any) error {
+	if o.json {
+		return o.encode(map[string]string{"result": fmt.Sprintf(format, args...)})
+	}
+	_, err := fmt.Fprintf(o.w, format+"\n", args...)
+	return err
+}
+
+func formatParams(p map[string]string) string {
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+p[k])
+	}
+	return strings.Join(parts, ",")
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (o output) nodes(nodes []client.NodeStatus) error {
+	rows := make([][]string, 0, len(nodes))
+	for _, n := range nodes {
+		rows = append(rows, []string{n.Node, string(n.State), deref(n.Error)})
+	}
+	fmt.Fprintln(o.w)
+	return o.table(nodes, []string{"NODE", "STATE", "ERROR"}, rows)
+}
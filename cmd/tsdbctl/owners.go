@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+func ownerCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	if len(args) == 0 {
+		return errors.New("owner command reg, unreg, heartbeat or list is required")
+	}
+	switch args[0] {
+	case "reg":
+		return ownerRegCmd(ctx, c, out, args[1:])
+	case "unreg":
+		return ownerUnRegCmd(ctx, c, out, args[1:])
+	case "heartbeat":
+		return ownerHeartbeatCmd(ctx, c, out, args[1:])
+	case "list":
+		return ownerListCmd(ctx, c, out, args[1:])
+	}
+	return fmt.Errorf("unknown owner command %q", args[0])
+}
+
+func ownerRegCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("owner reg")
+	owner := fs.String("owner", "", "owner to register")
+	kinds := fs.String("kinds", "", "comma separated kinds of the owner")
+	ttl := fs.Duration("ttl", 0, "owner expires unless it beats within ttl, 0 never expires")
+	weight := fs.Int("weight", 0, "share of the tasks relative to the other owners, 0 is 1")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *owner == "" || *kinds == "" {
+		return errors.New("owner and kinds are required")
+	}
+
+	r := client.OwnerRegRequest{
+		Owner:  *owner,
+		Kinds:  strings.Split(*kinds, ","),
+		Ttl:    ttl.Milliseconds(),
+		Weight: *weight,
+	}
+	if err := c.Register(ctx, r); err != nil {
+		return err
+	}
+	return out.message("registered %s", *owner)
+}
+
+func ownerUnRegCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("owner unreg")
+	owner := fs.String("owner", "", "owner to unregister")
+	kind := fs.String("kind", "", "kind to unregister from, every kind by default")
+	handoff := fs.Bool("handoff", false, "hand the tasks of the owner over to the other owners")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *owner == "" {
+		return errors.New("owner is required")
+	}
+
+	if err := c.OwnerUnRegKind(ctx, *owner, *kind, *handoff); err != nil {
+		return err
+	}
+	return out.message("unregistered %s", *owner)
+}
+
+func ownerHeartbeatCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("owner heartbeat")
+	owner := fs.String("owner", "", "owner to keep alive")
+	ttl := fs.Duration("ttl", 30*time.Second, "owner expires unless it beats again within ttl")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *owner == "" {
+		return errors.New("owner is required")
+	}
+
+	if err := c.OwnerHeartbeat(ctx, *owner, *ttl); err != nil {
+		return err
+	}
+	return out.message("%s alive for %v", *owner, *ttl)
+}
+
+func ownerListCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("owner list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	owners, err := c.Owners(ctx)
+	if err != nil {
+		return err
+	}
+	if owners == nil {
+		owners = []client.OwnerKinds{}
+	}
+	rows := make([][]string, 0, len(owners))
+	for _, o := range owners {
+		rows = append(rows, []string{o.Owner, strings.Join(o.Kinds, ",")})
+	}
+	return out.table(owners, []string{"OWNER", "KINDS"}, rows)
+}
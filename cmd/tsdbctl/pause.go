@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+func pauseCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("pause")
+	kind := fs.String("kind", "", "kind to pause")
+	group := fs.String("group", "", "group to pause")
+	list := fs.Bool("list", false, "list paused kinds and groups")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *list {
+		pauses, err := c.Paused(ctx)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(pauses))
+		for _, p := range pauses {
+			rows = append(rows, []string{p.Kind, p.Group, p.Ts.Format(time.RFC3339)})
+		}
+		return out.table(pauses, []string{"KIND", "GROUP", "TS"}, rows)
+	}
+	if (*kind == "") == (*group == "") {
+		return errors.New("kind or group is required")
+	}
+	if err := c.Pause(ctx, *kind, *group); err != nil {
+		return err
+	}
+	return out.message("paused %s%s", *kind, *group)
+}
+
+func resumeCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("resume")
+	kind := fs.String("kind", "", "kind to resume")
+	group := fs.String("group", "", "group to resume")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*kind == "") == (*group == "") {
+		return errors.New("kind or group is required")
+	}
+
+	if err := c.Resume(ctx, *kind, *group); err != nil {
+		return err
+	}
+	return out.message("resumed %s%s", *kind, *group)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+// nodeStatus is the status of one cluster node as seen by tsdbctl.
+type nodeStatus struct {
+	Node  string             `json:"node"`
+	Raft  *client.RaftStatus `json:"raft,omitempty"`
+	Error string             `json:"error,omitempty"`
+}
+
+func statusCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("status")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	info, err := c.Status(ctx)
+	if err != nil {
+		return err
+	}
+	nodes := info.Nodes
+	if len(nodes) == 0 {
+		nodes = []string{info.Node}
+	}
+
+	res := make([]nodeStatus, 0, len(nodes))
+	for _, node := range nodes {
+		s := nodeStatus{Node: node}
+		if node == info.Node {
+			s.Raft = info.Raft
+		} else if i, err := c.StatusOf(ctx, node); err != nil {
+			s.Error = err.Error()
+		} else {
+			s.Raft = i.Raft
+		}
+		res = append(res, s)
+	}
+
+	rows := make([][]string, 0, len(res))
+	for _, s := range res {
+		row := []string{s.Node, "", "", "", "", "", "", s.Error}
+		if r := s.Raft; r != nil {
+			row = []string{
+				s.Node,
+				r.State,
+				r.Leader,
+				strconv.FormatUint(r.Term, 10),
+				strconv.FormatUint(r.LastIndex, 10),
+				strconv.FormatUint(r.AppliedIndex, 10),
+				strconv.Itoa(r.Peers),
+				s.Error,
+			}
+		}
+		rows = append(rows, row)
+	}
+	return out.table(res, []string{"NODE", "STATE", "LEADER", "TERM", "LAST", "APPLIED", "PEERS", "ERROR"}, rows)
+}
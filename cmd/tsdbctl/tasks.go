@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/pkg/client"
+)
+
+func addCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("add")
+	group := fs.String("group", "", "group of the task")
+	kind := fs.String("kind", "", "kind of the task")
+	owner := fs.String("owner", "", "owner of the task, assigned by the cluster when empty")
+	p := params{}
+	fs.Var(p, "param", "param key=value, repeatable")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *group == "" || *kind == "" {
+		return errors.New("group and kind are required")
+	}
+
+	var o *string
+	if *owner != "" {
+		o = owner
+	}
+	id, err := c.Add(ctx, *group, *kind, o, p)
+	if err != nil {
+		return err
+	}
+	if out.json {
+		return out.encode(map[string]string{"id": id})
+	}
+	return out.message("%s", id)
+}
+
+func getCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("get")
+	group := fs.String("group", "", "group of the task")
+	id := fs.String("id", "", "id of the task")
+	first := fs.Bool("first", false, "get the first task in the group")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *group == "" || (*id == "" && !*first) {
+		return errors.New("group and id or first are required")
+	}
+
+	if *first {
+		first, err := c.GetFirstInGroup(ctx, *group)
+		if err != nil {
+			return err
+		}
+		if first == "" {
+			return errors.New("no task to run in group")
+		}
+		*id = first
+	}
+	task, err := c.Get(ctx, *group, *id)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		return errors.New("task not found")
+	}
+	return out.tasks([]client.Task{*task})
+}
+
+func updateCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("update")
+	group := fs.String("group", "", "group of the task")
+	id := fs.String("id", "", "id of the task")
+	status := fs.String("status", "", "new status of the task")
+	errorTxt := fs.String("error", "", "error of a failed task")
+	p := params{}
+	fs.Var(p, "param", "param key=value, repeatable")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *group == "" || *id == "" || *status == "" {
+		return errors.New("group, id and status are required")
+	}
+
+	s, err := parseStatus(*status)
+	if err != nil {
+		return err
+	}
+	var e *string
+	if *errorTxt != "" {
+		e = errorTxt
+	}
+	if err = c.Update(ctx, *group, *id, s, p, e); err != nil {
+		return err
+	}
+	return out.message("updated %s", *id)
+}
+
+func searchCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("search")
+	f := filter{}
+	addFilterFlags(fs, &f)
+	partial := fs.Bool("partial", false, "return the tasks of reachable nodes and report the others")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	r, err := f.request()
+	if err != nil {
+		return err
+	}
+
+	if *partial && !f.errors {
+		res, err := c.SearchTaskPartial(ctx, r)
+		if err != nil {
+			return err
+		}
+		if out.json {
+			return out.encode(res)
+		}
+		if err = out.tasks(res.Tasks); err != nil {
+			return err
+		}
+		return out.nodes(res.Nodes)
+	}
+
+	var tasks []client.Task
+	if f.errors {
+		tasks, err = c.SearchError(ctx, r)
+	} else {
+		tasks, err = c.SearchTask(ctx, r)
+	}
+	if err != nil {
+		return err
+	}
+	return out.tasks(tasks)
+}
+
+func searchDeleteCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("search-delete")
+	f := filter{}
+	addFilterFlags(fs, &f)
+	all := fs.Bool("all", false, "allow deleting without filters")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if f.empty() && !*all {
+		return errors.New("filters or all are required")
+	}
+	r, err := f.request()
+	if err != nil {
+		return err
+	}
+
+	if f.errors {
+		err = c.SearchDeleteError(ctx, r.Condition, r.Kind, r.Size)
+	} else {
+		err = c.SearchDeleteTask(ctx, r.Condition, r.Kind, r.Size)
+	}
+	if err != nil {
+		return err
+	}
+	return out.message("deleted")
+}
+
+func searchUpdateCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("search-update")
+	f := filter{}
+	addFilterFlags(fs, &f)
+	all := fs.Bool("all", false, "allow updating without filters")
+	setKind := fs.String("set-kind", "", "new kind")
+	setGroup := fs.String("set-group", "", "new group")
+	setOwner := fs.String("set-owner", "", "new owner")
+	setStatus := fs.String("set-status", "", "new status")
+	setError := fs.String("set-error", "", "new error")
+	p := params{}
+	fs.Var(p, "set-param", "new param key=value, repeatable")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if f.empty() && !*all {
+		return errors.New("filters or all are required")
+	}
+	r, err := f.request()
+	if err != nil {
+		return err
+	}
+
+	up := client.TaskUpdate{}
+	if *setKind != "" {
+		up.Kind = setKind
+	}
+	if *setGroup != "" {
+		up.Group = setGroup
+	}
+	if *setOwner != "" {
+		up.Owner = setOwner
+	}
+	if *setStatus != "" {
+		s, err := parseStatus(*setStatus)
+		if err != nil {
+			return err
+		}
+		up.Status = &s
+	}
+	if *setError != "" {
+		up.Error = setError
+	}
+	if len(p) > 0 {
+		up.Param = p
+	}
+	if up.Kind == nil && up.Group == nil && up.Owner == nil && up.Status == nil && up.Error == nil && up.Param == nil {
+		return errors.New("nothing to update")
+	}
+
+	if f.errors {
+		err = c.SearchUpdateError(ctx, up, r.Condition, r.Kind, r.Size)
+	} else {
+		err = c.SearchUpdateTask(ctx, up, r.Condition, r.Kind, r.Size)
+	}
+	if err != nil {
+		return err
+	}
+	return out.message("updated")
+}
+
+func poolCmd(ctx context.Context, c *client.Client, out output, args []string) error {
+	fs := newFlags("pool")
+	owner := fs.String("owner", "", "owner of the pool")
+	kind := fs.String("kind", "", "kind of tasks")
+	wait := fs.Duration("wait", 0, "wait for tasks when the pool is empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *owner == "" || *kind == "" {
+		return errors.New("owner and kind are required")
+	}
+
+	tasks, err := c.Pool(ctx, *owner, *kind, *wait)
+	if err != nil {
+		return err
+	}
+	return out.tasks(tasks)
+}
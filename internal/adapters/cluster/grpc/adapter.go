@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// same policy as the http client: retry while the peer is unavailable
+const serviceConfig = `{
+	"methodConfig": [{
+		"name": [{"service": "taskstoredb.cluster.v1.Cluster"}],
+		"retryPolicy": {
+			"maxAttempts": 4,
+			"initialBackoff": "1s",
+			"maxBackoff": "9s",
+			"backoffMultiplier": 3,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+
+type GrpcClusterAdapter struct {
+	clients map[string]clusterpb.ClusterClient
+	conns   []*grpc.ClientConn
+}
+
+// NewGrpcClusterAdapter takes the grpc address of every cluster node keyed by its ring url.
+func NewGrpcClusterAdapter(addrs map[string]string, opts ...grpc.DialOption) (*GrpcClusterAdapter, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("grpc addresses is empty")
+	}
+
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}, append(namespaceOptions(), opts...)...)
+
+	a := &GrpcClusterAdapter{clients: make(map[string]clusterpb.ClusterClient, len(addrs))}
+	for url, addr := range addrs {
+		conn, err := grpc.NewClient(addr, opts...)
+		if err != nil {
+			a.Close()
+			return nil, fmt.Errorf("create grpc client %v error: %v", addr, err)
+		}
+		a.conns = append(a.conns, conn)
+		a.clients[url] = clusterpb.NewClusterClient(conn)
+	}
+
+	return a, nil
+}
+
+func (a *GrpcClusterAdapter) Close() error {
+	var errs []error
+	for _, conn := range a.conns {
+		errs = append(errs, conn.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (a *GrpcClusterAdapter) client(url string) (clusterpb.ClusterClient, error) {
+	c, ok := a.clients[url]
+	if !ok {
+		return nil, fmt.Errorf("not found grpc address by url: %v", url)
+	}
+	return c, nil
+}
+
+// isError keeps the quota, context and slow subscriber errors of a call to
+// url, so fan-outs tell a timed out node from a failed one.
+func (a *GrpcClusterAdapter) isError(url string, err error) error {
+	s := status.Convert(err)
+	switch s.Code() {
+	case codes.ResourceExhausted:
+		return fmt.Errorf("request url %v error: %w", url, contract.QuotaError{Msg: s.Message()})
+	case codes.DeadlineExceeded:
+		return fmt.Errorf("request url %v error: %w", url, context.DeadlineExceeded)
+	case codes.Canceled:
+		return fmt.Errorf("request url %v error: %w", url, context.Canceled)
+	case codes.Aborted:
+		return fmt.Errorf("request url %v error: %w", url, contract.ErrSlowSubscriber)
+	}
+	return fmt.Errorf("request url %v error: %v", url, s.Message())
+}
+
+func (a *GrpcClusterAdapter) recvTasks(url string, stream grpc.ServerStreamingClient[clusterpb.TaskChunk]) (tasks []contract.Task, err error) {
+	err = a.recvChunks(url, stream, func(chunk []contract.Task) error {
+		tasks = append(tasks, chunk...)
+		return nil
+	})
+	return tasks, err
+}
+
+// recvChunks hands every chunk of the stream to send as it arrives.
+func (a *GrpcClusterAdapter) recvChunks(
+	url string,
+	stream grpc.ServerStreamingClient[clusterpb.TaskChunk],
+	send func(tasks []contract.Task) error,
+) error {
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return a.isError(url, err)
+		}
+		tasks := make([]contract.Task, 0, len(chunk.GetTasks()))
+		for _, t := range chunk.GetTasks() {
+			tasks = append(tasks, clusterpb.TaskFromProto(t))
+		}
+		if err = send(tasks); err != nil {
+			return err
+		}
+	}
+}
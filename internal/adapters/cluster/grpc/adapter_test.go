@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGrpcClusterAdapter_isError(t *testing.T) {
+	a := &GrpcClusterAdapter{}
+	for code, want := range map[codes.Code]error{
+		codes.DeadlineExceeded: context.DeadlineExceeded,
+		codes.Canceled:         context.Canceled,
+	} {
+		if err := a.isError("n1", status.Error(code, "stop")); !errors.Is(err, want) {
+			t.Errorf("not correct error of %v: %v", code, err)
+		}
+	}
+	var quota contract.QuotaError
+	if err := a.isError("n1", status.Error(codes.ResourceExhausted, "full")); !errors.As(err, &quota) || quota.Msg != "full" {
+		t.Errorf("not correct quota error %v", err)
+	}
+	err := a.isError("n1", status.Error(codes.Internal, "down"))
+	if err == nil || errors.Is(err, context.DeadlineExceeded) || err.Error() != "request url n1 error: down" {
+		t.Errorf("not correct error %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) Add(
+	ctx context.Context,
+	url string,
+	group string,
+	kind string,
+	owner *string,
+	param map[string]string,
+) (id string, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return id, err
+	}
+
+	res, err := c.Add(ctx, &clusterpb.AddRequest{
+		Group: group,
+		Kind:  kind,
+		Owner: owner,
+		Param: param,
+	})
+	if err != nil {
+		return id, a.isError(url, err)
+	}
+
+	return res.GetId(), nil
+}
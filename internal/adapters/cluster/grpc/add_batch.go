@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) AddBatch(
+	ctx context.Context,
+	url string,
+	tasks []contract.AddRequest,
+) (items []contract.BatchItem, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &clusterpb.AddBatchRequest{Tasks: make([]*clusterpb.AddRequest, 0, len(tasks))}
+	for _, t := range tasks {
+		r.Tasks = append(r.Tasks, &clusterpb.AddRequest{
+			Group: t.Group,
+			Kind:  t.Kind,
+			Owner: t.Owner,
+			Param: t.Param,
+		})
+	}
+
+	res, err := c.AddBatch(ctx, r)
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+
+	return clusterpb.BatchItemsFromProto(res), nil
+}
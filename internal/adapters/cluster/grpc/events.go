@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+// Events hands the events of url to send until ctx is done or the stream fails.
+func (a *GrpcClusterAdapter) Events(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	from contract.EventCursor,
+	send func(e contract.TaskEvent) error,
+) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	cond, err := clusterpb.ConditionToProto(condition)
+	if err != nil {
+		return err
+	}
+
+	stream, err := c.Events(ctx, &clusterpb.EventsRequest{
+		Condition: cond,
+		Index:     from.Index,
+		Offset:    int64(from.Offset),
+	})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			return a.isError(url, err)
+		}
+		if err = send(clusterpb.TaskEventFromProto(e)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) Get(
+	ctx context.Context,
+	url string,
+	group string,
+	id string,
+) (task *contract.Task, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Get(ctx, &clusterpb.GetRequest{Group: group, Id: id})
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+	if res.Task == nil {
+		return nil, nil
+	}
+
+	t := clusterpb.TaskFromProto(res.Task)
+	return &t, nil
+}
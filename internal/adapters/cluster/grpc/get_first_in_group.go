@@ -0,0 +1,25 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) GetFirstInGroup(
+	ctx context.Context,
+	url string,
+	group string,
+) (id string, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return id, err
+	}
+
+	res, err := c.GetFirstInGroup(ctx, &clusterpb.GetFirstInGroupRequest{Group: group})
+	if err != nil {
+		return id, a.isError(url, err)
+	}
+
+	return res.GetId(), nil
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) InFlight(ctx context.Context, url string, kind string) (n int, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return n, err
+	}
+
+	res, err := c.InFlight(ctx, &clusterpb.InFlightRequest{Kind: kind})
+	if err != nil {
+		return n, a.isError(url, err)
+	}
+
+	return int(res.GetCount()), nil
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) KindConfigSet(ctx context.Context, url string, config contract.KindConfig) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.KindConfigSet(ctx, clusterpb.KindConfigToProto(config))
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// namespaceKey is the metadata of the namespace of a call, empty is the default one.
+const namespaceKey = "x-namespace"
+
+func withNamespace(ctx context.Context) context.Context {
+	if ns := contract.NamespaceFrom(ctx); ns != "" {
+		return metadata.AppendToOutgoingContext(ctx, namespaceKey, ns)
+	}
+	return ctx
+}
+
+// namespaceOptions send the namespace of every call to the other node.
+func namespaceOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(withNamespace(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withNamespace(ctx), desc, cc, method, opts...)
+		}),
+	}
+}
+
+func (a *GrpcClusterAdapter) NamespaceCreate(ctx context.Context, url string, ns contract.Namespace) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.NamespaceCreate(ctx, clusterpb.NamespaceToProto(ns))
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
+
+func (a *GrpcClusterAdapter) NamespaceDrop(ctx context.Context, url string, name string) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.NamespaceDrop(ctx, &clusterpb.NamespaceDropRequest{Name: name})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
+
+func (a *GrpcClusterAdapter) Namespaces(ctx context.Context, url string) (namespaces []contract.Namespace, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Namespaces(ctx, &clusterpb.Empty{})
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+
+	for _, ns := range res.GetNamespaces() {
+		namespaces = append(namespaces, clusterpb.NamespaceFromProto(ns))
+	}
+	return namespaces, nil
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) OwnerHeartbeat(ctx context.Context, url string, owner string, ttl time.Duration) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.OwnerHeartbeat(ctx, &clusterpb.OwnerHeartbeatRequest{Owner: owner, TtlMs: ttl.Milliseconds()})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) OwnerReg(ctx context.Context, url string, owner string, kinds []string, ttl time.Duration, weight int) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.OwnerReg(ctx, &clusterpb.OwnerRegRequest{Owner: owner, Kinds: kinds, TtlMs: ttl.Milliseconds(), Weight: int32(weight)})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) OwnerUnReg(ctx context.Context, url string, owner string, kind string, handoff bool) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.OwnerUnReg(ctx, &clusterpb.OwnerUnRegRequest{Owner: owner, Kind: kind, Handoff: handoff})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
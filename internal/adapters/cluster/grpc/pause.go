@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) Pause(ctx context.Context, url string, kind string, group string, paused bool) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Pause(ctx, &clusterpb.PauseRequest{Kind: kind, Group: group, Paused: paused})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) Pool(
+	ctx context.Context,
+	url string,
+	owner string,
+	kind string,
+	wait time.Duration,
+) (tasks []contract.Task, err error) {
+	return a.pool(ctx, url, &clusterpb.PoolRequest{
+		Owner:  owner,
+		Kind:   kind,
+		WaitMs: wait.Milliseconds(),
+	})
+}
+
+// PoolLease asks the coordinator node of a limited kind to lease tasks.
+func (a *GrpcClusterAdapter) PoolLease(
+	ctx context.Context,
+	url string,
+	owner string,
+	kind string,
+	wait time.Duration,
+) (tasks []contract.Task, err error) {
+	return a.pool(ctx, url, &clusterpb.PoolRequest{
+		Owner:  owner,
+		Kind:   kind,
+		WaitMs: wait.Milliseconds(),
+		Lease:  true,
+	})
+}
+
+func (a *GrpcClusterAdapter) pool(ctx context.Context, url string, r *clusterpb.PoolRequest) (tasks []contract.Task, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.Pool(ctx, r)
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+
+	return a.recvTasks(url, stream)
+}
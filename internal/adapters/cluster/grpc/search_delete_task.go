@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) SearchDeleteTask(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	cond, err := clusterpb.ConditionToProto(condition)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.SearchDeleteTask(ctx, &clusterpb.SearchRequest{
+		Condition: cond,
+		Kind:      kind,
+		Size:      clusterpb.SizeToProto(size),
+	})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
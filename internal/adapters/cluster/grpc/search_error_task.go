@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+	"google.golang.org/grpc"
+)
+
+func (a *GrpcClusterAdapter) SearchErrorTask(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+) (tasks []contract.Task, err error) {
+	stream, err := a.searchErrorTask(ctx, url, condition, kind, size)
+	if err != nil {
+		return nil, err
+	}
+	return a.recvTasks(url, stream)
+}
+
+// SearchErrorTaskChunks hands the tasks of url to send chunk by chunk as they arrive.
+func (a *GrpcClusterAdapter) SearchErrorTaskChunks(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) (err error) {
+	stream, err := a.searchErrorTask(ctx, url, condition, kind, size)
+	if err != nil {
+		return err
+	}
+	return a.recvChunks(url, stream, send)
+}
+
+func (a *GrpcClusterAdapter) searchErrorTask(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+) (grpc.ServerStreamingClient[clusterpb.TaskChunk], error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	cond, err := clusterpb.ConditionToProto(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.SearchErrorTask(ctx, &clusterpb.SearchRequest{
+		Condition: cond,
+		Kind:      kind,
+		Size:      clusterpb.SizeToProto(size),
+	})
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+	return stream, nil
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+	"google.golang.org/grpc"
+)
+
+func (a *GrpcClusterAdapter) SearchTask(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+) (tasks []contract.Task, err error) {
+	stream, err := a.searchTask(ctx, url, condition, kind, size)
+	if err != nil {
+		return nil, err
+	}
+	return a.recvTasks(url, stream)
+}
+
+// SearchTaskChunks hands the tasks of url to send chunk by chunk as they arrive.
+func (a *GrpcClusterAdapter) SearchTaskChunks(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) (err error) {
+	stream, err := a.searchTask(ctx, url, condition, kind, size)
+	if err != nil {
+		return err
+	}
+	return a.recvChunks(url, stream, send)
+}
+
+func (a *GrpcClusterAdapter) searchTask(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+) (grpc.ServerStreamingClient[clusterpb.TaskChunk], error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	cond, err := clusterpb.ConditionToProto(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.SearchTask(ctx, &clusterpb.SearchRequest{
+		Condition: cond,
+		Kind:      kind,
+		Size:      clusterpb.SizeToProto(size),
+	})
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+	return stream, nil
+}
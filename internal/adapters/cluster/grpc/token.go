@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// tokenCredentials sends the cluster token as bearer token of every call.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// the cluster transport may run without tls
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// WithToken calls the other nodes with the cluster token.
+func WithToken(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(tokenCredentials(token))
+}
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) Update(
+	ctx context.Context,
+	url string,
+	group string,
+	id string,
+	status contract.Status,
+	param map[string]string,
+	error *string,
+) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Update(ctx, &clusterpb.UpdateRequest{
+		Id:     id,
+		Group:  group,
+		Status: int32(status),
+		Param:  param,
+		Error:  error,
+	})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
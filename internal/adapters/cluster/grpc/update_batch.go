@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) UpdateBatch(
+	ctx context.Context,
+	url string,
+	tasks []contract.UpdateRequest,
+) (items []contract.BatchItem, err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &clusterpb.UpdateBatchRequest{Tasks: make([]*clusterpb.UpdateRequest, 0, len(tasks))}
+	for _, t := range tasks {
+		r.Tasks = append(r.Tasks, &clusterpb.UpdateRequest{
+			Id:     t.Id,
+			Group:  t.Group,
+			Status: int32(t.Status),
+			Param:  t.Param,
+			Error:  t.Error,
+		})
+	}
+
+	res, err := c.UpdateBatch(ctx, r)
+	if err != nil {
+		return nil, a.isError(url, err)
+	}
+
+	return clusterpb.BatchItemsFromProto(res), nil
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) WebhookReg(ctx context.Context, url string, webhook contract.Webhook) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WebhookReg(ctx, clusterpb.WebhookToProto(webhook))
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
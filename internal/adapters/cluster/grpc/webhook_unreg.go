@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+)
+
+func (a *GrpcClusterAdapter) WebhookUnReg(ctx context.Context, url string, id string) (err error) {
+	c, err := a.client(url)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WebhookUnReg(ctx, &clusterpb.WebhookUnRegRequest{Id: id})
+	if err != nil {
+		return a.isError(url, err)
+	}
+
+	return nil
+}
@@ -1,23 +1,69 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
+// Observer is told the latency of every call of a node, err is set when the
+// call failed or the node answered with a server error.
+type Observer func(peer string, d time.Duration, err error)
+
 type HttpClusterAdapter struct {
-	client *http.Client
+	client  *http.Client
+	token   string
+	observe Observer
 }
 
-func NewHttpClusterAdapter(client *http.Client) HttpClusterAdapter {
+// NewHttpClusterAdapter calls the other nodes with the cluster token as bearer
+// token, if any. The observer may be nil.
+func NewHttpClusterAdapter(client *http.Client, token string, observe Observer) HttpClusterAdapter {
 	return HttpClusterAdapter{
-		client: client,
+		client:  client,
+		token:   token,
+		observe: observe,
+	}
+}
+
+func (a HttpClusterAdapter) do(
+	ctx context.Context,
+	method string,
+	url string,
+	body io.Reader,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("create request error: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
 	}
+	if ns := contract.NamespaceFrom(ctx); ns != "" {
+		req.Header.Set(contract.NamespaceHeader, ns)
+	}
+	if a.observe == nil {
+		return a.client.Do(req)
+	}
+
+	start := time.Now()
+	resp, err := a.client.Do(req)
+	callErr := err
+	if err == nil && resp.StatusCode >= http.StatusInternalServerError {
+		callErr = fmt.Errorf("httpcode %v", resp.StatusCode)
+	}
+	a.observe(req.URL.Host, time.Since(start), callErr)
+	return resp, err
 }
 
 func (a HttpClusterAdapter) isError(resp *http.Response) error {
@@ -34,9 +80,51 @@ func (a HttpClusterAdapter) isError(resp *http.Response) error {
 			if err != nil {
 				return fmt.Errorf("response format error: %v", err)
 			}
+			if resp.StatusCode == http.StatusTooManyRequests {
+				return contract.QuotaError{Msg: r.Error}
+			}
 			return errors.New(r.Error)
 		}
 	}
 
 	return fmt.Errorf("httpcode %v", resp.StatusCode)
 }
+
+// chunkSize is the count of decoded tasks handed to the caller at once
+const chunkSize = 100
+
+// decodeChunks hands the tasks of a json array to send chunk by chunk as they
+// are decoded.
+func decodeChunks(body io.Reader, send func(tasks []contract.Task) error) error {
+	dec := json.NewDecoder(body)
+	t, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("response format error: %v", err)
+	}
+	if t == nil {
+		return nil
+	}
+	if t != json.Delim('[') {
+		return fmt.Errorf("response format error: unexpected %v", t)
+	}
+	chunk := make([]contract.Task, 0, chunkSize)
+	for dec.More() {
+		task := contract.Task{}
+		if err := dec.Decode(&task); err != nil {
+			return fmt.Errorf("response format error: %v", err)
+		}
+		if chunk = append(chunk, task); len(chunk) == chunkSize {
+			if err := send(chunk); err != nil {
+				return err
+			}
+			chunk = make([]contract.Task, 0, chunkSize)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("response format error: %v", err)
+	}
+	if len(chunk) > 0 {
+		return send(chunk)
+	}
+	return nil
+}
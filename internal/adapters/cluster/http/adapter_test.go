@@ -0,0 +1,63 @@
+package http
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestDecodeChunks(t *testing.T) {
+	var body strings.Builder
+	body.WriteString("[")
+	for i := range chunkSize + 1 {
+		if i > 0 {
+			body.WriteString(",")
+		}
+		body.WriteString(`{"id":"t-TEST-1","k":"TEST"}`)
+	}
+	body.WriteString("]")
+
+	var chunks []int
+	send := func(tasks []contract.Task) error {
+		chunks = append(chunks, len(tasks))
+		return nil
+	}
+	if err := decodeChunks(strings.NewReader(body.String()), send); err != nil || !slices.Equal(chunks, []int{chunkSize, 1}) {
+		t.Errorf("not correct chunks %v %v", chunks, err)
+	}
+
+	chunks = nil
+	if err := decodeChunks(strings.NewReader("null"), send); err != nil || len(chunks) != 0 {
+		t.Errorf("not correct empty response %v %v", chunks, err)
+	}
+	if err := decodeChunks(strings.NewReader(`{"error":"x"}`), send); err == nil {
+		t.Errorf("not correct response accepted")
+	}
+}
+
+func TestDecodeEvents(t *testing.T) {
+	body := ": keepalive\n\n" +
+		"id: 3.0\nevent: task\ndata: {\"i\":3,\"y\":\"task\"}\n\n" +
+		"event: slow\ndata: {\"error\":\"events subscriber is too slow\"}\n\n"
+
+	var events []contract.TaskEvent
+	send := func(e contract.TaskEvent) error {
+		events = append(events, e)
+		return nil
+	}
+	err := decodeEvents(strings.NewReader(body), send)
+	if !errors.Is(err, contract.ErrSlowSubscriber) {
+		t.Errorf("not correct end of stream %v", err)
+	}
+	if len(events) != 1 || events[0].Index != 3 || events[0].Type != contract.TaskPut {
+		t.Errorf("not correct events %v", events)
+	}
+
+	err = decodeEvents(strings.NewReader("event: error\ndata: {\"error\":\"x\"}\n\n"), send)
+	if err == nil || err.Error() != "x" {
+		t.Errorf("not correct error event %v", err)
+	}
+}
@@ -2,13 +2,16 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) Add(
+	ctx context.Context,
 	url string,
 	group string,
 	kind string,
@@ -27,7 +30,7 @@ func (a HttpClusterAdapter) Add(
 		return id, fmt.Errorf("request format error: %v", err)
 	}
 
-	resp, err := a.client.Post(url+"/task", "application/json", bytes.NewBuffer(json_data))
+	resp, err := a.do(ctx, http.MethodPost, url+"/task", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
@@ -37,7 +40,7 @@ func (a HttpClusterAdapter) Add(
 
 	err = a.isError(resp)
 	if err != nil {
-		return id, fmt.Errorf("request url %v error: %v", url, err)
+		return id, fmt.Errorf("request url %v error: %w", url, err)
 	}
 
 	var res contract.AddResponse
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (a HttpClusterAdapter) AddBatch(
+	ctx context.Context,
+	url string,
+	tasks []contract.AddRequest,
+) (items []contract.BatchItem, err error) {
+	r := contract.BatchAddRequest{Tasks: tasks}
+
+	json_data, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("request format error: %v", err)
+	}
+
+	resp, err := a.do(ctx, http.MethodPost, url+"/task/batch", bytes.NewBuffer(json_data))
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return nil, fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	var res contract.BatchResponse
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("response format error: %v", err)
+	}
+
+	return res.Items, nil
+}
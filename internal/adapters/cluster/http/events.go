@@ -0,0 +1,104 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+// Events hands the events of node to send until ctx is done or the stream fails.
+func (a HttpClusterAdapter) Events(
+	ctx context.Context,
+	node string,
+	condition *contract.Condition,
+	from contract.EventCursor,
+	send func(e contract.TaskEvent) error,
+) (err error) {
+	q := url.Values{"internal": {"true"}}
+	if from != (contract.EventCursor{}) {
+		q.Set("from", from.String())
+	}
+	if condition != nil {
+		c, err := json.Marshal(condition)
+		if err != nil {
+			return fmt.Errorf("request format error: %v", err)
+		}
+		q.Set("c", string(c))
+	}
+
+	resp, err := a.do(ctx, http.MethodGet, node+"/events?"+q.Encode(), nil)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", node, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", node, err)
+	}
+
+	err = decodeEvents(resp.Body, send)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("request url %v error: %w", node, err)
+	}
+	return fmt.Errorf("events stream of %v ended", node)
+}
+
+// decodeEvents hands the events of a server-sent events stream to send, a
+// stream of a node ends with a slow or an error event.
+func decodeEvents(body io.Reader, send func(e contract.TaskEvent) error) error {
+	r := bufio.NewReader(body)
+	var event, data string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.EOF
+			}
+			return fmt.Errorf("response format error: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			data = value
+		case "":
+			// a comment or the end of an event
+			if line != "" || data == "" {
+				continue
+			}
+			switch event {
+			case "slow":
+				return contract.ErrSlowSubscriber
+			case "error":
+				var r contract.ErrorResponse
+				if err := json.Unmarshal([]byte(data), &r); err != nil {
+					return fmt.Errorf("response format error: %v", err)
+				}
+				return errors.New(r.Error)
+			}
+			e := contract.TaskEvent{}
+			if err := json.Unmarshal([]byte(data), &e); err != nil {
+				return fmt.Errorf("response format error: %v", err)
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+			event, data = "", ""
+		}
+	}
+}
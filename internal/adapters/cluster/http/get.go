@@ -1,18 +1,21 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) Get(
+	ctx context.Context,
 	url string,
 	group string,
 	id string,
 ) (task *contract.Task, err error) {
-	resp, err := a.client.Get(url + "/task/" + id + "/group/" + group)
+	resp, err := a.do(ctx, http.MethodGet, url+"/task/"+id+"/group/"+group, nil)
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
@@ -25,6 +28,7 @@ func (a HttpClusterAdapter) Get(
 		return nil, fmt.Errorf("request url %v error: %v", url, err)
 	}
 
+	task = &contract.Task{}
 	err = json.NewDecoder(resp.Body).Decode(task)
 	if err != nil {
 		return nil, fmt.Errorf("response format error: %v", err)
@@ -1,17 +1,20 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) GetFirstInGroup(
+	ctx context.Context,
 	url string,
 	group string,
 ) (id string, err error) {
-	resp, err := a.client.Get(url + "/task/group/" + group)
+	resp, err := a.do(ctx, http.MethodGet, url+"/task/group/"+group, nil)
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (a HttpClusterAdapter) InFlight(
+	ctx context.Context,
+	url string,
+	kind string,
+) (n int, err error) {
+	resp, err := a.do(ctx, http.MethodGet, url+"/kind/"+kind+"/inflight?internal=true", nil)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return n, fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return n, fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	var res contract.InFlightResponse
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return n, fmt.Errorf("response format error: %v", err)
+	}
+
+	return res.Count, err
+}
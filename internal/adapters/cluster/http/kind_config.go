@@ -0,0 +1,38 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (a HttpClusterAdapter) KindConfigSet(ctx context.Context, url string, config contract.KindConfig) (err error) {
+	r := contract.KindConfigRequest{
+		KindConfig: config,
+		Internal:   true,
+	}
+
+	json_data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("request format error: %v", err)
+	}
+
+	resp, err := a.do(ctx, http.MethodPut, url+"/kind/config", bytes.NewBuffer(json_data))
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	return err
+}
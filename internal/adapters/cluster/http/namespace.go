@@ -0,0 +1,77 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (a HttpClusterAdapter) NamespaceCreate(ctx context.Context, url string, ns contract.Namespace) (err error) {
+	r := contract.NamespaceRequest{
+		Namespace: ns,
+		Internal:  true,
+	}
+
+	json_data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("request format error: %v", err)
+	}
+
+	resp, err := a.do(ctx, http.MethodPut, url+"/namespace", bytes.NewBuffer(json_data))
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	return err
+}
+
+func (a HttpClusterAdapter) NamespaceDrop(ctx context.Context, url string, name string) (err error) {
+	resp, err := a.do(ctx, http.MethodDelete, url+"/namespace/"+name+"?internal=true", nil)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	return err
+}
+
+func (a HttpClusterAdapter) Namespaces(ctx context.Context, url string) (namespaces []contract.Namespace, err error) {
+	resp, err := a.do(ctx, http.MethodGet, url+"/namespace?internal=true", nil)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return nil, fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&namespaces)
+	if err != nil {
+		return nil, fmt.Errorf("response format error: %v", err)
+	}
+
+	return namespaces, err
+}
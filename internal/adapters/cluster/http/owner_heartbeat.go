@@ -0,0 +1,40 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (a HttpClusterAdapter) OwnerHeartbeat(ctx context.Context, url string, owner string, ttl time.Duration) (err error) {
+	r := contract.OwnerHeartbeatRequest{
+		Owner:    owner,
+		Ttl:      ttl.Milliseconds(),
+		Internal: true,
+	}
+
+	json_data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("request format error: %v", err)
+	}
+
+	resp, err := a.do(ctx, http.MethodPut, url+"/owner/heartbeat", bytes.NewBuffer(json_data))
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	return err
+}
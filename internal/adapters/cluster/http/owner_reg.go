@@ -2,17 +2,21 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
-func (a HttpClusterAdapter) OwnerReg(url string, owner string, kinds []string) (err error) {
+func (a HttpClusterAdapter) OwnerReg(ctx context.Context, url string, owner string, kinds []string, ttl time.Duration, weight int) (err error) {
 	r := contract.OwnerRegRequest{
 		Owner:    owner,
 		Kinds:    kinds,
+		Ttl:      ttl.Milliseconds(),
+		Weight:   weight,
 		Internal: true,
 	}
 
@@ -21,13 +25,7 @@ func (a HttpClusterAdapter) OwnerReg(url string, owner string, kinds []string) (
 		return fmt.Errorf("request format error: %v", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url+"/owner/reg", bytes.NewBuffer(json_data))
-	req.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return fmt.Errorf("create request error: %v", err)
-	}
-
-	resp, err := a.client.Do(req)
+	resp, err := a.do(ctx, http.MethodPut, url+"/owner/reg", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
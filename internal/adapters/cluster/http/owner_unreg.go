@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,9 +10,11 @@ import (
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
-func (a HttpClusterAdapter) OwnerUnReg(url string, owner string) (err error) {
+func (a HttpClusterAdapter) OwnerUnReg(ctx context.Context, url string, owner string, kind string, handoff bool) (err error) {
 	r := contract.OwnerUnRegRequest{
 		Owner:    owner,
+		Kind:     kind,
+		Handoff:  handoff,
 		Internal: true,
 	}
 
@@ -20,13 +23,7 @@ func (a HttpClusterAdapter) OwnerUnReg(url string, owner string) (err error) {
 		return fmt.Errorf("request format error: %v", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url+"/owner/unreg", bytes.NewBuffer(json_data))
-	req.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return fmt.Errorf("create request error: %v", err)
-	}
-
-	resp, err := a.client.Do(req)
+	resp, err := a.do(ctx, http.MethodPut, url+"/owner/unreg", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (a HttpClusterAdapter) Pause(ctx context.Context, url string, kind string, group string, paused bool) (err error) {
+	r := contract.PauseRequest{
+		Kind:     kind,
+		Group:    group,
+		Internal: true,
+	}
+
+	json_data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("request format error: %v", err)
+	}
+
+	path := "/resume"
+	if paused {
+		path = "/pause"
+	}
+	resp, err := a.do(ctx, http.MethodPut, url+path, bytes.NewBuffer(json_data))
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	err = a.isError(resp)
+	if err != nil {
+		return fmt.Errorf("request url %v error: %v", url, err)
+	}
+
+	return err
+}
@@ -1,18 +1,49 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) Pool(
+	ctx context.Context,
 	url string,
 	owner string,
 	kind string,
+	wait time.Duration,
 ) (tasks []contract.Task, err error) {
-	resp, err := a.client.Get(url + "/pool/" + owner + "/kind/" + kind + "?internal=true")
+	return a.pool(ctx, url, owner, kind, "internal=true", wait)
+}
+
+// PoolLease asks the coordinator node of a limited kind to lease tasks.
+func (a HttpClusterAdapter) PoolLease(
+	ctx context.Context,
+	url string,
+	owner string,
+	kind string,
+	wait time.Duration,
+) (tasks []contract.Task, err error) {
+	return a.pool(ctx, url, owner, kind, "lease=true", wait)
+}
+
+func (a HttpClusterAdapter) pool(
+	ctx context.Context,
+	url string,
+	owner string,
+	kind string,
+	mode string,
+	wait time.Duration,
+) (tasks []contract.Task, err error) {
+	u := url + "/pool/" + owner + "/kind/" + kind + "?" + mode
+	if wait > 0 {
+		u += "&wait=" + wait.String()
+	}
+	resp, err := a.do(ctx, http.MethodGet, u, nil)
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
@@ -2,13 +2,16 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) SearchDeleteErrorTask(
+	ctx context.Context,
 	url string,
 	condition *contract.Condition,
 	kind *string,
@@ -26,7 +29,7 @@ func (a HttpClusterAdapter) SearchDeleteErrorTask(
 		return fmt.Errorf("request format error: %v", err)
 	}
 
-	resp, err := a.client.Post(url+"/error/search/delete", "application/json", bytes.NewBuffer(json_data))
+	resp, err := a.do(ctx, http.MethodPost, url+"/error/search/delete", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
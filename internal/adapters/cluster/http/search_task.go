@@ -2,18 +2,40 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) SearchTask(
+	ctx context.Context,
 	url string,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 ) (tasks []contract.Task, err error) {
+	err = a.SearchTaskChunks(ctx, url, condition, kind, size, func(chunk []contract.Task) error {
+		tasks = append(tasks, chunk...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// SearchTaskChunks hands the tasks of url to send chunk by chunk as they are decoded.
+func (a HttpClusterAdapter) SearchTaskChunks(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) (err error) {
 	r := contract.SearchTaskRequest{
 		Condition: condition,
 		Kind:      kind,
@@ -23,27 +45,22 @@ func (a HttpClusterAdapter) SearchTask(
 
 	json_data, err := json.Marshal(r)
 	if err != nil {
-		return nil, fmt.Errorf("request format error: %v", err)
+		return fmt.Errorf("request format error: %v", err)
 	}
 
-	resp, err := a.client.Post(url+"/task/search", "application/json", bytes.NewBuffer(json_data))
+	resp, err := a.do(ctx, http.MethodPost, url+"/task/search", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("request url %v error: %v", url, err)
+		return fmt.Errorf("request url %v error: %v", url, err)
 	}
 
 	err = a.isError(resp)
 	if err != nil {
-		return nil, fmt.Errorf("request url %v error: %v", url, err)
-	}
-
-	err = json.NewDecoder(resp.Body).Decode(&tasks)
-	if err != nil {
-		return nil, fmt.Errorf("response format error: %v", err)
+		return fmt.Errorf("request url %v error: %v", url, err)
 	}
 
-	return tasks, err
+	return decodeChunks(resp.Body, send)
 }
@@ -2,13 +2,16 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (a HttpClusterAdapter) SearchUpdateErrorTask(
+	ctx context.Context,
 	url string,
 	up contract.TaskUpdate,
 	condition *contract.Condition,
@@ -28,7 +31,7 @@ func (a HttpClusterAdapter) SearchUpdateErrorTask(
 		return fmt.Errorf("request format error: %v", err)
 	}
 
-	resp, err := a.client.Post(url+"/error/search/update", "application/json", bytes.NewBuffer(json_data))
+	resp, err := a.do(ctx, http.MethodPost, url+"/error/search/update", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
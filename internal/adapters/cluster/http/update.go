@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 )
 
 func (a HttpClusterAdapter) Update(
+	ctx context.Context,
 	url string,
 	group string,
 	id string,
@@ -30,13 +32,7 @@ func (a HttpClusterAdapter) Update(
 		return fmt.Errorf("request format error: %v", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, url+"/task", bytes.NewBuffer(json_data))
-	req.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return fmt.Errorf("create request error: %v", err)
-	}
-
-	resp, err := a.client.Do(req)
+	resp, err := a.do(ctx, http.MethodPatch, url+"/task", bytes.NewBuffer(json_data))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
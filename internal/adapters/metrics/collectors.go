@@ -0,0 +1,254 @@
+package metrics
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const mb = 1 << 20
+
+func desc(name string, help string, labels ...string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, labels, nil)
+}
+
+type raftCollector struct {
+	raft       *raft.Raft
+	state      *prometheus.Desc
+	term       *prometheus.Desc
+	lastIndex  *prometheus.Desc
+	commit     *prometheus.Desc
+	applied    *prometheus.Desc
+	fsmPending *prometheus.Desc
+}
+
+// RaftCollector exports the state and the indexes of the raft of the node.
+func RaftCollector(r *raft.Raft) prometheus.Collector {
+	return raftCollector{
+		raft:       r,
+		state:      desc("raft_state", "Raft state of the node, 1 for the current one.", "state"),
+		term:       desc("raft_term", "Current raft term."),
+		lastIndex:  desc("raft_last_log_index", "Index of the last raft log entry."),
+		commit:     desc("raft_commit_index", "Index of the last committed raft log entry."),
+		applied:    desc("raft_applied_index", "Index of the last raft log entry applied to the store."),
+		fsmPending: desc("raft_fsm_pending", "Committed raft log entries waiting to be applied."),
+	}
+}
+
+func (c raftCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.state
+	ch <- c.term
+	ch <- c.lastIndex
+	ch <- c.commit
+	ch <- c.applied
+	ch <- c.fsmPending
+}
+
+func (c raftCollector) Collect(ch chan<- prometheus.Metric) {
+	state := c.raft.State()
+	for _, s := range []raft.RaftState{raft.Follower, raft.Candidate, raft.Leader, raft.Shutdown} {
+		v := 0.0
+		if s == state {
+			v = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.state, prometheus.GaugeValue, v, s.String())
+	}
+	stats := c.raft.Stats()
+	for d, key := range map[*prometheus.Desc]string{
+		c.term:       "term",
+		c.lastIndex:  "last_log_index",
+		c.commit:     "commit_index",
+		c.applied:    "applied_index",
+		c.fsmPending: "fsm_pending",
+	} {
+		if v, err := strconv.ParseFloat(stats[key], 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v)
+		}
+	}
+}
+
+type levelDbCollector struct {
+	db              *leveldb.DB
+	tables          *prometheus.Desc
+	size            *prometheus.Desc
+	compactionTime  *prometheus.Desc
+	compactionRead  *prometheus.Desc
+	compactionWrite *prometheus.Desc
+	ioRead          *prometheus.Desc
+	ioWrite         *prometheus.Desc
+	writeDelays     *prometheus.Desc
+	writeDelayTime  *prometheus.Desc
+	writePaused     *prometheus.Desc
+	openTables      *prometheus.Desc
+	blockCache      *prometheus.Desc
+	iterators       *prometheus.Desc
+	snapshots       *prometheus.Desc
+}
+
+// LevelDbCollector exports the sizes and the compactions of every level and
+// the io of the store.
+func LevelDbCollector(db *leveldb.DB) prometheus.Collector {
+	return levelDbCollector{
+		db:              db,
+		tables:          desc("leveldb_tables", "Tables of a level.", "level"),
+		size:            desc("leveldb_size_bytes", "Size of the tables of a level.", "level"),
+		compactionTime:  desc("leveldb_compaction_seconds_total", "Time spent compacting into a level.", "level"),
+		compactionRead:  desc("leveldb_compaction_read_bytes_total", "Bytes read by compactions into a level.", "level"),
+		compactionWrite: desc("leveldb_compaction_write_bytes_total", "Bytes written by compactions into a level.", "level"),
+		ioRead:          desc("leveldb_io_read_bytes_total", "Bytes read from the storage."),
+		ioWrite:         desc("leveldb_io_write_bytes_total", "Bytes written to the storage."),
+		writeDelays:     desc("leveldb_write_delays_total", "Writes delayed by compactions."),
+		writeDelayTime:  desc("leveldb_write_delay_seconds_total", "Time writes were delayed by compactions."),
+		writePaused:     desc("leveldb_write_paused", "1 while writes are paused by compactions."),
+		openTables:      desc("leveldb_open_tables", "Tables kept open."),
+		blockCache:      desc("leveldb_block_cache_bytes", "Size of the block cache."),
+		iterators:       desc("leveldb_alive_iterators", "Iterators not released."),
+		snapshots:       desc("leveldb_alive_snapshots", "Snapshots not released."),
+	}
+}
+
+func (c levelDbCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.tables, c.size, c.compactionTime, c.compactionRead, c.compactionWrite,
+		c.ioRead, c.ioWrite, c.writeDelays, c.writeDelayTime, c.writePaused,
+		c.openTables, c.blockCache, c.iterators, c.snapshots,
+	} {
+		ch <- d
+	}
+}
+
+func (c levelDbCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := leveldb.DBStats{}
+	if err := c.db.Stats(&stats); err != nil {
+		return
+	}
+	paused := 0.0
+	if stats.WritePaused {
+		paused = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.ioRead, prometheus.CounterValue, float64(stats.IORead))
+	ch <- prometheus.MustNewConstMetric(c.ioWrite, prometheus.CounterValue, float64(stats.IOWrite))
+	ch <- prometheus.MustNewConstMetric(c.writeDelays, prometheus.CounterValue, float64(stats.WriteDelayCount))
+	ch <- prometheus.MustNewConstMetric(c.writeDelayTime, prometheus.CounterValue, stats.WriteDelayDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.writePaused, prometheus.GaugeValue, paused)
+	ch <- prometheus.MustNewConstMetric(c.openTables, prometheus.GaugeValue, float64(stats.OpenedTablesCount))
+	ch <- prometheus.MustNewConstMetric(c.blockCache, prometheus.GaugeValue, float64(stats.BlockCacheSize))
+	ch <- prometheus.MustNewConstMetric(c.iterators, prometheus.GaugeValue, float64(stats.AliveIterators))
+	ch <- prometheus.MustNewConstMetric(c.snapshots, prometheus.GaugeValue, float64(stats.AliveSnapshots))
+
+	// the stats of a level are only listed with their level in the property
+	levels, err := c.db.GetProperty("leveldb.stats")
+	if err != nil {
+		return
+	}
+	for _, l := range parseLevels(levels) {
+		ch <- prometheus.MustNewConstMetric(c.tables, prometheus.GaugeValue, l.tables, l.level)
+		ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, l.size, l.level)
+		ch <- prometheus.MustNewConstMetric(c.compactionTime, prometheus.CounterValue, l.time, l.level)
+		ch <- prometheus.MustNewConstMetric(c.compactionRead, prometheus.CounterValue, l.read, l.level)
+		ch <- prometheus.MustNewConstMetric(c.compactionWrite, prometheus.CounterValue, l.write, l.level)
+	}
+}
+
+type levelStats struct {
+	level  string
+	tables float64
+	size   float64
+	time   float64
+	read   float64
+	write  float64
+}
+
+// parseLevels reads the rows "level | tables | size MB | time s | read MB | write MB"
+// of the leveldb.stats property.
+func parseLevels(stats string) (levels []levelStats) {
+	for _, line := range strings.Split(stats, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 6 {
+			continue
+		}
+		values := make([]float64, 0, 5)
+		for _, f := range fields[1:] {
+			v, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+			if err != nil {
+				break
+			}
+			values = append(values, v)
+		}
+		level := strings.TrimSpace(fields[0])
+		if _, err := strconv.Atoi(level); err != nil || len(values) != 5 {
+			continue
+		}
+		levels = append(levels, levelStats{
+			level:  level,
+			tables: values[0],
+			size:   values[1] * mb,
+			time:   values[2],
+			read:   values[3] * mb,
+			write:  values[4] * mb,
+		})
+	}
+	return levels
+}
+
+type TaskCounter interface {
+	TaskCounts() (list []contract.TaskCount)
+}
+
+type tasksCollector struct {
+	counter TaskCounter
+	tasks   *prometheus.Desc
+	errors  *prometheus.Desc
+}
+
+// TasksCollector exports the tasks of the node by kind and status and the
+// error tasks by kind, in every namespace.
+func TasksCollector(counter TaskCounter) prometheus.Collector {
+	return tasksCollector{
+		counter: counter,
+		tasks:   desc("tasks", "Tasks of the node by kind and status.", "namespace", "kind", "status"),
+		errors:  desc("error_tasks", "Error tasks of the node by kind.", "namespace", "kind"),
+	}
+}
+
+func (c tasksCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tasks
+	ch <- c.errors
+}
+
+func (c tasksCollector) Collect(ch chan<- prometheus.Metric) {
+	type kind struct{ namespace, kind string }
+	errors := make(map[kind]int64)
+	for _, t := range c.counter.TaskCounts() {
+		if t.Error {
+			errors[kind{t.Namespace, t.Kind}] += t.Count
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.tasks, prometheus.GaugeValue, float64(t.Count), t.Namespace, t.Kind, statusName(t.Status))
+	}
+	for k, n := range errors {
+		ch <- prometheus.MustNewConstMetric(c.errors, prometheus.GaugeValue, float64(n), k.namespace, k.kind)
+	}
+}
+
+func statusName(s contract.Status) string {
+	switch s {
+	case contract.VIRGIN:
+		return "VIRGIN"
+	case contract.SCHEDULED:
+		return "SCHEDULED"
+	case contract.COMPLETED:
+		return "COMPLETED"
+	case contract.FAILED:
+		return "FAILED"
+	case contract.CANCELLED:
+		return "CANCELLED"
+	case contract.PAUSED:
+		return "PAUSED"
+	}
+	return strconv.Itoa(int(s))
+}
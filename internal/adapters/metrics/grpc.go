@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DialOptions record the calls of a grpc cluster client like ObserveCall,
+// a stream is done when it is read to the end or fails.
+func (m *Metrics) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			start := time.Now()
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			m.ObserveCall(cc.Target(), time.Since(start), err)
+			return err
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			start := time.Now()
+			stream, err := streamer(ctx, desc, cc, method, opts...)
+			if err != nil {
+				m.ObserveCall(cc.Target(), time.Since(start), err)
+				return nil, err
+			}
+			return &observedStream{ClientStream: stream, done: func(err error) {
+				m.ObserveCall(cc.Target(), time.Since(start), err)
+			}}, nil
+		}),
+	}
+}
+
+type observedStream struct {
+	grpc.ClientStream
+	done func(err error)
+}
+
+func (s *observedStream) RecvMsg(msg any) error {
+	err := s.ClientStream.RecvMsg(msg)
+	if err != nil && s.done != nil {
+		if errors.Is(err, io.EOF) {
+			s.done(nil)
+		} else {
+			s.done(err)
+		}
+		s.done = nil
+	}
+	return err
+}
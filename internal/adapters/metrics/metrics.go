@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "taskstore"
+
+// Metrics are the prometheus metrics of a node, served by Handler.
+type Metrics struct {
+	registry    *prometheus.Registry
+	requests    *prometheus.CounterVec
+	requestTime *prometheus.HistogramVec
+	calls       *prometheus.HistogramVec
+	callErrors  *prometheus.CounterVec
+	applyTime   prometheus.Histogram
+	applyErrors prometheus.Counter
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Http requests by route, method and status code.",
+		}, []string{"route", "method", "code"}),
+		requestTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of http requests by route, method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method", "code"}),
+		calls: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "cluster_call_duration_seconds",
+			Help:      "Latency of calls of the other nodes by peer.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"peer"}),
+		callErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cluster_call_errors_total",
+			Help:      "Failed calls of the other nodes by peer.",
+		}, []string{"peer"}),
+		applyTime: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "raft_apply_duration_seconds",
+			Help:      "Time a command waits for its events to be committed and applied.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		applyErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "raft_apply_errors_total",
+			Help:      "Commands whose events failed to be applied.",
+		}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestTime,
+		m.calls,
+		m.callErrors,
+		m.applyTime,
+		m.applyErrors,
+	)
+	return m
+}
+
+// Register adds collectors of the node, the raft, the store and the tasks.
+func (m *Metrics) Register(cs ...prometheus.Collector) error {
+	for _, c := range cs {
+		if err := m.registry.Register(c); err != nil {
+			return fmt.Errorf("register collector error: %v", err)
+		}
+	}
+	return nil
+}
+
+// Handler serves the metrics in the prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRequest records an http request, route is the pattern it matched.
+func (m *Metrics) ObserveRequest(route string, method string, status int, d time.Duration) {
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(route, method, code).Inc()
+	m.requestTime.WithLabelValues(route, method, code).Observe(d.Seconds())
+}
+
+// ObserveCall records a call of the node peer.
+func (m *Metrics) ObserveCall(peer string, d time.Duration, err error) {
+	m.calls.WithLabelValues(peer).Observe(d.Seconds())
+	if err != nil {
+		m.callErrors.WithLabelValues(peer).Inc()
+	}
+}
+
+// ObserveApply records the commit of the events of a command.
+func (m *Metrics) ObserveApply(d time.Duration, err error) {
+	m.applyTime.Observe(d.Seconds())
+	if err != nil {
+		m.applyErrors.Inc()
+	}
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type counter []contract.TaskCount
+
+func (c counter) TaskCounts() []contract.TaskCount {
+	return c
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New()
+	m.ObserveRequest("/task", "POST", 200, time.Millisecond)
+	m.ObserveCall("n2:8080", time.Millisecond, errors.New("down"))
+	m.ObserveApply(time.Millisecond, nil)
+	err := m.Register(TasksCollector(counter{
+		{Kind: "TEST", Status: contract.VIRGIN, Count: 2},
+		{Kind: "TEST", Status: contract.FAILED, Error: true, Count: 1},
+		{Kind: "TEST", Status: contract.CANCELLED, Error: true, Count: 1},
+		{Namespace: "a", Kind: "TEST", Status: contract.SCHEDULED, Count: 3},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body := w.Body.String()
+	for _, line := range []string{
+		`taskstore_http_requests_total{code="200",method="POST",route="/task"} 1`,
+		`taskstore_cluster_call_errors_total{peer="n2:8080"} 1`,
+		`taskstore_raft_apply_duration_seconds_count 1`,
+		`taskstore_tasks{kind="TEST",namespace="",status="VIRGIN"} 2`,
+		`taskstore_tasks{kind="TEST",namespace="a",status="SCHEDULED"} 3`,
+		`taskstore_error_tasks{kind="TEST",namespace=""} 2`,
+	} {
+		if !strings.Contains(body, line) {
+			t.Errorf("not correct metrics, missing %s", line)
+		}
+	}
+}
+
+func TestMetrics_ParseLevels(t *testing.T) {
+	stats := "Compactions\n" +
+		" Level |   Tables   |    Size(MB)   |    Time(sec)  |    Read(MB)   |   Write(MB)\n" +
+		"-------+------------+---------------+---------------+---------------+---------------\n" +
+		"   0   |          2 |       1.00000 |       0.50000 |       0.00000 |       2.00000\n" +
+		"   2   |          1 |       0.50000 |       0.00000 |       0.00000 |       0.00000\n"
+	levels := parseLevels(stats)
+	if len(levels) != 2 || levels[0].level != "0" || levels[0].tables != 2 || levels[0].size != mb ||
+		levels[0].time != 0.5 || levels[0].write != 2*mb || levels[1].level != "2" || levels[1].size != mb/2 {
+		t.Errorf("not correct levels %+v", levels)
+	}
+}
+
+func TestMetrics_LevelDbCollector(t *testing.T) {
+	db, err := leveldb.OpenFile(t.TempDir(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if n := testutil.CollectAndCount(LevelDbCollector(db), "taskstore_leveldb_io_write_bytes_total"); n != 1 {
+		t.Errorf("not correct leveldb metrics %d", n)
+	}
+}
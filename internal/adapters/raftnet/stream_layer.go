@@ -0,0 +1,56 @@
+package raftnet
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// TlsStreamLayer is a raft stream layer over tls, the node certificate of
+// the dialer is verified by the listening node.
+type TlsStreamLayer struct {
+	listener  net.Listener
+	advertise net.Addr
+	client    *tls.Config
+}
+
+func NewTlsStreamLayer(bind string, advertise net.Addr, server *tls.Config, client *tls.Config) (*TlsStreamLayer, error) {
+	if server == nil || client == nil {
+		return nil, errors.New("nil tls config")
+	}
+	listener, err := tls.Listen("tcp", bind, server)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on %v: %v", bind, err)
+	}
+	if advertise == nil {
+		advertise = listener.Addr()
+	}
+	return &TlsStreamLayer{listener: listener, advertise: advertise, client: client}, nil
+}
+
+func (s *TlsStreamLayer) Accept() (net.Conn, error) {
+	return s.listener.Accept()
+}
+
+func (s *TlsStreamLayer) Close() error {
+	return s.listener.Close()
+}
+
+func (s *TlsStreamLayer) Addr() net.Addr {
+	return s.advertise
+}
+
+func (s *TlsStreamLayer) Dial(address raft.ServerAddress, timeout time.Duration) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(string(address))
+	if err != nil {
+		return nil, fmt.Errorf("raft address %v error: %v", address, err)
+	}
+	config := s.client.Clone()
+	config.ServerName = host
+	dialer := &tls.Dialer{NetDialer: &net.Dialer{Timeout: timeout}, Config: config}
+	return dialer.Dial("tcp", string(address))
+}
@@ -0,0 +1,91 @@
+package raftnet
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// selfSigned is a certificate of 127.0.0.1 that is its own ca.
+func selfSigned(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func TestTlsStreamLayer(t *testing.T) {
+	cert, pool := selfSigned(t)
+	server := &tls.Config{Certificates: []tls.Certificate{cert}, ClientCAs: pool, ClientAuth: tls.RequireAndVerifyClientCert}
+	client := &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: pool}
+
+	stream, err := NewTlsStreamLayer("127.0.0.1:0", nil, server, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	go func() {
+		conn, err := stream.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.Copy(conn, io.LimitReader(conn, 4))
+	}()
+
+	conn, err := stream.Dial(raft.ServerAddress(stream.Addr().String()), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("ping"))
+	b := make([]byte, 4)
+	if _, err = io.ReadFull(conn, b); err != nil || string(b) != "ping" {
+		t.Errorf("not correct echo %q %v", b, err)
+	}
+
+	// a dialer without certificate is rejected by the listening node
+	stranger, err := NewTlsStreamLayer("127.0.0.1:0", nil, server, &tls.Config{RootCAs: pool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stranger.Close()
+	go func() {
+		if conn, err := stream.Accept(); err == nil {
+			conn.Read(make([]byte, 1))
+			conn.Close()
+		}
+	}()
+	if conn, err = stranger.Dial(raft.ServerAddress(stream.Addr().String()), time.Second); err == nil {
+		defer conn.Close()
+		if _, err = conn.Read(b); err == nil {
+			t.Errorf("not correct dialer without certificate accepted")
+		}
+	}
+}
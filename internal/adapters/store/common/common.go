@@ -1,9 +1,17 @@
 package common
 
 const (
-	PrefixTask   = "t"
-	PrefixError  = "e"
-	PrefixGroup  = "g"
-	PrefixOwner  = "o"
-	PrefixOffset = "f"
+	PrefixTask    = "t"
+	PrefixError   = "e"
+	PrefixGroup   = "g"
+	PrefixOwner   = "o"
+	PrefixOffset  = "f"
+	PrefixWebhook = "w"
+	PrefixOutbox  = "x"
+	PrefixPause   = "p"
+	PrefixKind    = "k"
+	PrefixBeat    = "h"
+	// the record of a namespace and the keyspace n-{namespace}- of its keys
+	PrefixNamespace = "s"
+	PrefixKeyspace  = "n"
 )
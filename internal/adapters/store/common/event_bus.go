@@ -0,0 +1,131 @@
+package common
+
+import (
+	"math"
+	"sync"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	subscriberBuffer = 256
+)
+
+type subscriber struct {
+	condition *contract.Condition
+	ch        chan contract.TaskEvent
+}
+
+// EventBus keeps the last applied task events for resuming clients and
+// fans new ones out to subscribers.
+type EventBus struct {
+	mu    sync.Mutex
+	buf   []contract.TaskEvent
+	start int
+	size  int
+	// events up to floor are unknown, evicted or restored from a snapshot
+	floor    contract.EventCursor
+	restored bool
+	// end of the last published entry
+	last   contract.EventCursor
+	subs   map[int]*subscriber
+	nextId int
+}
+
+func NewEventBus(size int) *EventBus {
+	return &EventBus{
+		buf:  make([]contract.TaskEvent, size),
+		last: contract.EventCursor{Offset: math.MaxInt},
+		subs: make(map[int]*subscriber),
+	}
+}
+
+func (b *EventBus) Publish(index uint64, events []contract.TaskEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.restored {
+		b.floor = contract.EventCursor{Index: index - 1, Offset: math.MaxInt}
+		b.restored = false
+	}
+	for _, e := range events {
+		if len(b.buf) == 0 {
+			b.floor = e.Cursor()
+		} else if b.size < len(b.buf) {
+			b.buf[(b.start+b.size)%len(b.buf)] = e
+			b.size++
+		} else {
+			b.floor = b.buf[b.start].Cursor()
+			b.buf[b.start] = e
+			b.start = (b.start + 1) % len(b.buf)
+		}
+		for id, s := range b.subs {
+			if !matchEvent(e, s.condition) {
+				continue
+			}
+			select {
+			case s.ch <- e:
+			default:
+				// a slow client is dropped, it resumes after its last event
+				close(s.ch)
+				delete(b.subs, id)
+			}
+		}
+	}
+	b.last = contract.EventCursor{Index: index, Offset: math.MaxInt}
+}
+
+// Reset drops buffered events when the state is replaced by a snapshot,
+// nothing is known before the next applied index.
+func (b *EventBus) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.start = 0
+	b.size = 0
+	b.restored = true
+}
+
+// Subscribe returns buffered events after from and a channel of new ones,
+// gap reports that events after from are no longer buffered, the zero from
+// subscribes to new events only. The events follow at, from or the last
+// published entry without from.
+func (b *EventBus) Subscribe(
+	condition *contract.Condition,
+	from contract.EventCursor,
+) (backlog []contract.TaskEvent, ch <-chan contract.TaskEvent, at contract.EventCursor, gap bool, cancel func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	at = b.last
+	if from != (contract.EventCursor{}) {
+		at = from
+		gap = from.Before(b.floor)
+		for i := 0; i < b.size; i++ {
+			e := b.buf[(b.start+i)%len(b.buf)]
+			if from.Before(e.Cursor()) && matchEvent(e, condition) {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+
+	id := b.nextId
+	b.nextId++
+	s := &subscriber{condition: condition, ch: make(chan contract.TaskEvent, subscriberBuffer)}
+	b.subs[id] = s
+
+	cancel = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[id]; ok {
+			close(s.ch)
+			delete(b.subs, id)
+		}
+	}
+	return backlog, s.ch, at, gap, cancel
+}
+
+func matchEvent(e contract.TaskEvent, condition *contract.Condition) bool {
+	if condition == nil {
+		return true
+	}
+	return ConditionCalculateTask(e.Task, condition)
+}
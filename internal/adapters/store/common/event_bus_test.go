@@ -0,0 +1,141 @@
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+// after is the end of the entry index.
+func after(index uint64) contract.EventCursor {
+	return contract.EventCursor{Index: index, Offset: math.MaxInt}
+}
+
+func TestEventBus_Subscribe(t *testing.T) {
+	b := NewEventBus(3)
+	for i := uint64(1); i <= 5; i++ {
+		b.Publish(i, []contract.TaskEvent{{Index: i, Type: contract.TaskPut, Task: &contract.Task{Kind: "TEST"}}})
+	}
+
+	backlog, _, _, gap, cancel := b.Subscribe(nil, after(3))
+	cancel()
+	if gap {
+		t.Errorf("not correct gap for buffered index")
+	}
+	if len(backlog) != 2 || backlog[0].Index != 4 || backlog[1].Index != 5 {
+		t.Errorf("not correct backlog %+v", backlog)
+	}
+
+	_, _, _, gap, cancel = b.Subscribe(nil, after(1))
+	cancel()
+	if !gap {
+		t.Errorf("expected gap for evicted index")
+	}
+
+	b.Reset()
+	b.Publish(10, nil)
+	backlog, _, _, gap, cancel = b.Subscribe(nil, after(5))
+	cancel()
+	if !gap || len(backlog) != 0 {
+		t.Errorf("expected gap for index before snapshot restore")
+	}
+	_, _, at, gap, cancel := b.Subscribe(nil, after(9))
+	cancel()
+	if gap || at != after(9) {
+		t.Errorf("not correct gap after snapshot restore %v", at)
+	}
+	if _, _, at, _, cancel = b.Subscribe(nil, contract.EventCursor{}); at != after(10) {
+		t.Errorf("not correct start of live events %v", at)
+	}
+	cancel()
+}
+
+func TestEventBus_SubscribeEmpty(t *testing.T) {
+	b := NewEventBus(3)
+	_, _, at, _, cancel := b.Subscribe(nil, contract.EventCursor{})
+	cancel()
+	if at != after(0) {
+		t.Errorf("not correct start of empty bus %v", at)
+	}
+
+	// a stream started on an empty bus resumes with every event since
+	b.Publish(1, []contract.TaskEvent{{Index: 1, Type: contract.TaskPut, Task: &contract.Task{Kind: "TEST"}}})
+	backlog, _, _, gap, cancel := b.Subscribe(nil, at)
+	cancel()
+	if gap || len(backlog) != 1 {
+		t.Errorf("not correct resume from empty bus %v %+v", gap, backlog)
+	}
+}
+
+func TestEventBus_Filter(t *testing.T) {
+	b := NewEventBus(10)
+	condition := &contract.Condition{
+		Operations: []contract.Operation{{Field: "kind", Value: "TEST", Operator: contract.Equal}},
+	}
+
+	_, ch, _, _, cancel := b.Subscribe(condition, contract.EventCursor{})
+	defer cancel()
+
+	b.Publish(1, []contract.TaskEvent{{Index: 1, Type: contract.TaskPut, Task: &contract.Task{Kind: "OTHER"}}})
+	b.Publish(2, []contract.TaskEvent{
+		{Index: 2, Type: contract.TaskPut, Task: &contract.Task{Kind: "TEST"}},
+	})
+
+	e := <-ch
+	if e.Index != 2 {
+		t.Errorf("not correct filtered event %+v", e)
+	}
+	select {
+	case e := <-ch:
+		t.Errorf("unexpected event %+v", e)
+	default:
+	}
+}
+
+func TestEventBus_ResumeInEntry(t *testing.T) {
+	b := NewEventBus(1000)
+	_, ch, _, _, cancel := b.Subscribe(nil, contract.EventCursor{})
+	defer cancel()
+
+	// one entry with more events than a subscriber buffers
+	events := make([]contract.TaskEvent, subscriberBuffer+10)
+	for i := range events {
+		events[i] = contract.TaskEvent{Index: 1, Offset: i, Type: contract.TaskPut, Task: &contract.Task{Kind: "TEST"}}
+	}
+	b.Publish(1, events)
+	b.Publish(2, []contract.TaskEvent{{Index: 2, Type: contract.TaskPut, Task: &contract.Task{Kind: "TEST"}}})
+
+	var last contract.EventCursor
+	received := 0
+	for e := range ch {
+		last = e.Cursor()
+		received++
+	}
+	if received != subscriberBuffer || last != (contract.EventCursor{Index: 1, Offset: subscriberBuffer - 1}) {
+		t.Fatalf("not correct events before drop %d %+v", received, last)
+	}
+
+	backlog, _, _, gap, cancelResume := b.Subscribe(nil, last)
+	cancelResume()
+	if gap || len(backlog) != len(events)-subscriberBuffer+1 {
+		t.Fatalf("not correct resume in entry %v %d", gap, len(backlog))
+	}
+	if backlog[0].Cursor() != (contract.EventCursor{Index: 1, Offset: subscriberBuffer}) || backlog[len(backlog)-1].Index != 2 {
+		t.Errorf("not correct resumed events %+v %+v", backlog[0], backlog[len(backlog)-1])
+	}
+}
+
+func TestEventBus_Cursor(t *testing.T) {
+	c, err := contract.ParseEventCursor("7.3")
+	if err != nil || c != (contract.EventCursor{Index: 7, Offset: 3}) || c.String() != "7.3" {
+		t.Errorf("not correct cursor %+v %v", c, err)
+	}
+	c, err = contract.ParseEventCursor("7")
+	if err != nil || c != after(7) || c.String() != "7" || !c.Before(contract.EventCursor{Index: 8}) {
+		t.Errorf("not correct cursor of entry %+v %v", c, err)
+	}
+	if _, err = contract.ParseEventCursor("7.x"); err == nil {
+		t.Errorf("not correct cursor accepted")
+	}
+}
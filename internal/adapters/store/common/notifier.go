@@ -0,0 +1,49 @@
+package common
+
+import (
+	"strings"
+	"sync"
+)
+
+type Notifier struct {
+	mu      sync.Mutex
+	waiters map[string]chan struct{}
+}
+
+func NewNotifier() *Notifier {
+	return &Notifier{waiters: make(map[string]chan struct{})}
+}
+
+// Watch returns a channel closed by the next Notify of key.
+func (n *Notifier) Watch(key string) <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ch, ok := n.waiters[key]
+	if !ok {
+		ch = make(chan struct{})
+		n.waiters[key] = ch
+	}
+	return ch
+}
+
+func (n *Notifier) Notify(key string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if ch, ok := n.waiters[key]; ok {
+		close(ch)
+		delete(n.waiters, key)
+	}
+}
+
+// KindFromTaskKey extracts the kind of a task key t-{kind}-{tsid}.
+func KindFromTaskKey(key string) (kind string, ok bool) {
+	rest, ok := strings.CutPrefix(key, PrefixTask+"-")
+	if !ok {
+		return kind, false
+	}
+	i := strings.LastIndex(rest, "-")
+	if i <= 0 {
+		return kind, false
+	}
+	return rest[:i], true
+}
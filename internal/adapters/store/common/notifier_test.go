@@ -0,0 +1,53 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotifier_Notify(t *testing.T) {
+	n := NewNotifier()
+	w1 := n.Watch("TEST")
+	w2 := n.Watch("TEST")
+	other := n.Watch("OTHER")
+
+	n.Notify("TEST")
+
+	for _, w := range []<-chan struct{}{w1, w2} {
+		select {
+		case <-w:
+		case <-time.After(time.Second):
+			t.Fatalf("watcher not notified")
+		}
+	}
+	select {
+	case <-other:
+		t.Errorf("not correct notify of other key")
+	default:
+	}
+
+	select {
+	case <-n.Watch("TEST"):
+		t.Errorf("new watcher must wait for the next notify")
+	default:
+	}
+}
+
+func TestNotifier_KindFromTaskKey(t *testing.T) {
+	var tests = []struct {
+		key  string
+		kind string
+		ok   bool
+	}{
+		{"t-TEST-06KBFY51C0001", "TEST", true},
+		{"e-TEST-06KBFY51C0001", "", false},
+		{"g-12345-06KBFY51C0001", "", false},
+		{"t-06KBFY51C0001", "", false},
+	}
+	for _, test := range tests {
+		kind, ok := KindFromTaskKey(test.key)
+		if kind != test.kind || ok != test.ok {
+			t.Errorf("Expected %v %v, got %v %v", test.kind, test.ok, kind, ok)
+		}
+	}
+}
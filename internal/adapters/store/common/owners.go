@@ -0,0 +1,298 @@
+package common
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/serialx/hashring"
+)
+
+// a reservation of a task not applied in time is dropped, the apply failed
+const reserveTtl = time.Minute
+
+// Owners is the registry of owners per kind that assigns new tasks by the
+// strategy of the kind, it follows the applied owner keys o-{kind}-{owner}
+// and kind configs.
+type Owners struct {
+	mu    sync.Mutex
+	kinds map[string]*kindOwners
+}
+
+type kindOwners struct {
+	owners    []string
+	weights   map[string]int
+	strategy  contract.Strategy
+	hashParam string
+	next      int
+	// smooth weighted round robin
+	current map[string]int
+	ring    *hashring.HashRing
+	// outstanding tasks per owner, LEAST_OUTSTANDING only
+	load map[string]int
+	// assigned tasks not applied yet
+	reserved   map[string]int
+	reservedAt time.Time
+}
+
+func NewOwners() *Owners {
+	return &Owners{kinds: make(map[string]*kindOwners)}
+}
+
+// Assign returns the owner of a new task of kind, nil without owners
+// or for an UNASSIGNED kind.
+func (o *Owners) Assign(kind string, param map[string]string) *string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k, ok := o.kinds[kind]
+	if !ok || len(k.owners) == 0 || k.strategy == contract.UNASSIGNED {
+		return nil
+	}
+	if k.strategy == contract.LEAST_OUTSTANDING {
+		if time.Since(k.reservedAt) > reserveTtl {
+			clear(k.reserved)
+		}
+		k.reservedAt = time.Now()
+	}
+	owner := k.pick(k.owners, k.ring, k.reserved, param)
+	return &owner
+}
+
+// Handoff returns the assign of the tasks of kind handed over to owners by
+// the strategy of the kind, the assigned tasks are the load of the owners
+// until the handoff is applied.
+func (o *Owners) Handoff(kind string, owners []string) func(param map[string]string) *string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k, ok := o.kinds[kind]
+	if !ok || len(owners) == 0 || k.strategy == contract.UNASSIGNED {
+		return func(map[string]string) *string { return nil }
+	}
+	weights := make(map[string]int, len(owners))
+	for _, owner := range owners {
+		weights[owner] = k.weights[owner]
+	}
+	ring := hashring.NewWithWeights(weights)
+	pending := make(map[string]int)
+	return func(param map[string]string) *string {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		owner := k.pick(owners, ring, pending, param)
+		return &owner
+	}
+}
+
+func (k *kindOwners) pick(owners []string, ring *hashring.HashRing, reserved map[string]int, param map[string]string) (owner string) {
+	switch k.strategy {
+	case contract.WEIGHTED:
+		owner = k.weighted(owners)
+	case contract.LEAST_OUTSTANDING:
+		owner = k.least(owners, reserved)
+	case contract.HASH:
+		if v, ok := param[k.hashParam]; ok && ring != nil {
+			owner, _ = ring.GetNode(v)
+		}
+	}
+	if owner == "" {
+		k.next++
+		owner = owners[k.next%len(owners)]
+	}
+	return owner
+}
+
+func (k *kindOwners) weighted(owners []string) string {
+	total, best := 0, ""
+	for _, owner := range owners {
+		w := k.weights[owner]
+		k.current[owner] += w
+		total += w
+		if best == "" || k.current[owner] > k.current[best] {
+			best = owner
+		}
+	}
+	k.current[best] -= total
+	return best
+}
+
+func (k *kindOwners) least(owners []string, reserved map[string]int) string {
+	if k.load == nil {
+		return ""
+	}
+	best := ""
+	for _, owner := range owners {
+		if best == "" ||
+			(k.load[owner]+reserved[owner])*k.weights[best] < (k.load[best]+reserved[best])*k.weights[owner] {
+			best = owner
+		}
+	}
+	reserved[best]++
+	return best
+}
+
+// Add registers owner for kind, a weight below 1 is 1.
+func (o *Owners) Add(kind string, owner string, weight int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k := o.kind(kind)
+	i, found := slices.BinarySearch(k.owners, owner)
+	if !found {
+		k.owners = slices.Insert(k.owners, i, owner)
+	}
+	k.weights[owner] = max(weight, 1)
+	k.changed()
+}
+
+func (o *Owners) Remove(kind string, owner string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k, ok := o.kinds[kind]
+	if !ok {
+		return
+	}
+	i, found := slices.BinarySearch(k.owners, owner)
+	if !found {
+		return
+	}
+	k.owners = slices.Delete(k.owners, i, i+1)
+	delete(k.weights, owner)
+	delete(k.current, owner)
+	k.changed()
+}
+
+// Configure sets the strategy of kind, true asks for the outstanding tasks
+// of the owners with SetLoad.
+func (o *Owners) Configure(config contract.KindConfig) (loads bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k := o.kind(config.Kind)
+	k.strategy = config.Strategy
+	k.hashParam = config.HashParam
+	if k.strategy != contract.LEAST_OUTSTANDING {
+		k.load = nil
+	}
+	return k.strategy == contract.LEAST_OUTSTANDING && k.load == nil
+}
+
+// Tracks reports whether the outstanding tasks of kind are counted.
+func (o *Owners) Tracks(kind string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k, ok := o.kinds[kind]
+	return ok && k.load != nil
+}
+
+// SetLoad sets the outstanding tasks per owner of kind.
+func (o *Owners) SetLoad(kind string, load map[string]int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k := o.kind(kind)
+	if k.strategy != contract.LEAST_OUTSTANDING {
+		return
+	}
+	k.load = load
+	clear(k.reserved)
+}
+
+// Loaded changes the outstanding tasks of owner by delta, added is an applied
+// new task that takes a reservation of Assign.
+func (o *Owners) Loaded(kind string, owner string, delta int, added bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k, ok := o.kinds[kind]
+	if !ok || k.load == nil {
+		return
+	}
+	k.load[owner] += delta
+	if added && k.reserved[owner] > 0 {
+		k.reserved[owner]--
+	}
+}
+
+// Reset replaces the registry with owner weights by kind and kind configs,
+// the loads are asked for again.
+func (o *Owners) Reset(owners map[string]map[string]int, configs []contract.KindConfig) (loads []string) {
+	o.mu.Lock()
+	o.kinds = make(map[string]*kindOwners, len(owners))
+	for kind, weights := range owners {
+		k := o.kind(kind)
+		for owner, weight := range weights {
+			k.owners = append(k.owners, owner)
+			k.weights[owner] = max(weight, 1)
+		}
+		sort.Strings(k.owners)
+		k.changed()
+	}
+	o.mu.Unlock()
+
+	for _, c := range configs {
+		if o.Configure(c) {
+			loads = append(loads, c.Kind)
+		}
+	}
+	return loads
+}
+
+// Owners returns the sorted owners of kind.
+func (o *Owners) Owners(kind string) []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if k, ok := o.kinds[kind]; ok {
+		return slices.Clone(k.owners)
+	}
+	return nil
+}
+
+// KindOwners lists every kind that has owners with their weights and strategy.
+func (o *Owners) KindOwners() (kinds []contract.KindOwners) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for kind, k := range o.kinds {
+		if len(k.owners) == 0 {
+			continue
+		}
+		weights := make([]int, 0, len(k.owners))
+		for _, owner := range k.owners {
+			weights = append(weights, k.weights[owner])
+		}
+		kinds = append(kinds, contract.KindOwners{
+			Kind:     kind,
+			Owners:   slices.Clone(k.owners),
+			Weights:  weights,
+			Strategy: k.strategy,
+		})
+	}
+	slices.SortFunc(kinds, func(a, b contract.KindOwners) int { return strings.Compare(a.Kind, b.Kind) })
+	return kinds
+}
+
+func (o *Owners) kind(kind string) *kindOwners {
+	k, ok := o.kinds[kind]
+	if !ok {
+		k = &kindOwners{
+			weights:  make(map[string]int),
+			current:  make(map[string]int),
+			reserved: make(map[string]int),
+		}
+		o.kinds[kind] = k
+	}
+	return k
+}
+
+func (k *kindOwners) changed() {
+	k.ring = nil
+	if len(k.owners) > 0 {
+		k.ring = hashring.NewWithWeights(k.weights)
+	}
+}
+
+// OwnerFromKey extracts kind and owner of an owner key o-{kind}-{owner}.
+func OwnerFromKey(key string) (kind string, owner string, ok bool) {
+	its := strings.Split(key, "-")
+	if len(its) != 3 || its[0] != PrefixOwner {
+		return kind, owner, false
+	}
+	return its[1], its[2], true
+}
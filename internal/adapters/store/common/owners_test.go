@@ -0,0 +1,185 @@
+package common
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestOwners_Assign(t *testing.T) {
+	o := NewOwners()
+	if owner := o.Assign("TEST", nil); owner != nil {
+		t.Errorf("not correct owner of kind without owners %v", *owner)
+	}
+
+	o.Add("TEST", "101", 0)
+	o.Add("TEST", "100", 0)
+	o.Add("TEST", "100", 0)
+	if owners := o.Owners("TEST"); !slices.Equal(owners, []string{"100", "101"}) {
+		t.Errorf("not correct owners %v", owners)
+	}
+	seen := map[string]int{}
+	for range 4 {
+		seen[*o.Assign("TEST", nil)]++
+	}
+	if seen["100"] != 2 || seen["101"] != 2 {
+		t.Errorf("not correct round robin %v", seen)
+	}
+
+	o.Remove("TEST", "100")
+	for range 2 {
+		if owner := o.Assign("TEST", nil); *owner != "101" {
+			t.Errorf("not correct owner after remove %v", *owner)
+		}
+	}
+	o.Remove("TEST", "101")
+	if kinds := o.KindOwners(); len(kinds) != 0 {
+		t.Errorf("not correct kinds without owners %v", kinds)
+	}
+
+	o.Reset(map[string]map[string]int{"A": {"2": 1, "1": 3}, "B": {"3": 1}}, nil)
+	kinds := o.KindOwners()
+	if len(kinds) != 2 || kinds[0].Kind != "A" || !slices.Equal(kinds[0].Owners, []string{"1", "2"}) ||
+		!slices.Equal(kinds[0].Weights, []int{3, 1}) {
+		t.Errorf("not correct kinds after reset %+v", kinds)
+	}
+}
+
+func TestOwners_Strategies(t *testing.T) {
+	o := NewOwners()
+	o.Add("TEST", "100", 3)
+	o.Add("TEST", "101", 1)
+	count := func(n int, param map[string]string) map[string]int {
+		seen := map[string]int{}
+		for range n {
+			if owner := o.Assign("TEST", param); owner != nil {
+				seen[*owner]++
+			} else {
+				seen[""]++
+			}
+		}
+		return seen
+	}
+
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.WEIGHTED})
+	if seen := count(8, nil); seen["100"] != 6 || seen["101"] != 2 {
+		t.Errorf("not correct weighted %v", seen)
+	}
+
+	if !o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.LEAST_OUTSTANDING}) {
+		t.Errorf("not correct load request")
+	}
+	o.SetLoad("TEST", map[string]int{"100": 3, "101": 0})
+	// 101 takes 1 to reach 100 per weight, then 3 to 1 are assigned
+	if seen := count(5, nil); seen["100"] != 3 || seen["101"] != 2 {
+		t.Errorf("not correct least outstanding %v", seen)
+	}
+	o.Loaded("TEST", "101", 1, true)
+	if !o.Tracks("TEST") {
+		t.Errorf("not correct tracking of least outstanding")
+	}
+
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.HASH, HashParam: "customer"})
+	if o.Tracks("TEST") {
+		t.Errorf("not correct tracking of hash")
+	}
+	for _, customer := range []string{"a", "b", "c"} {
+		param := map[string]string{"customer": customer}
+		if seen := count(3, param); len(seen) != 1 {
+			t.Errorf("not correct sticky owner of %v %v", customer, seen)
+		}
+	}
+
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.UNASSIGNED})
+	if seen := count(2, nil); seen[""] != 2 {
+		t.Errorf("not correct unassigned %v", seen)
+	}
+}
+
+func TestOwners_Handoff(t *testing.T) {
+	o := NewOwners()
+	o.Add("TEST", "100", 1)
+	o.Add("TEST", "101", 3)
+	o.Add("TEST", "102", 1)
+	count := func(n int, assign func(map[string]string) *string) map[string]int {
+		seen := map[string]int{}
+		for range n {
+			if owner := assign(nil); owner != nil {
+				seen[*owner]++
+			} else {
+				seen[""]++
+			}
+		}
+		return seen
+	}
+
+	// 100 leaves, its tasks go to the others by weight
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.WEIGHTED})
+	if seen := count(8, o.Handoff("TEST", []string{"101", "102"})); seen["101"] != 6 || seen["102"] != 2 {
+		t.Errorf("not correct weighted handoff %v", seen)
+	}
+
+	// handed over tasks are the load until applied
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.LEAST_OUTSTANDING})
+	o.SetLoad("TEST", map[string]int{"100": 5, "101": 3, "102": 0})
+	if seen := count(5, o.Handoff("TEST", []string{"101", "102"})); seen["101"] != 3 || seen["102"] != 2 {
+		t.Errorf("not correct least outstanding handoff %v", seen)
+	}
+	if owner := o.Assign("TEST", nil); owner == nil || *owner != "102" {
+		t.Errorf("not correct reservation after handoff %v", owner)
+	}
+
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.HASH, HashParam: "customer"})
+	assign := o.Handoff("TEST", []string{"101"})
+	if owner := assign(map[string]string{"customer": "a"}); owner == nil || *owner != "101" {
+		t.Errorf("not correct hash handoff %v", owner)
+	}
+
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.UNASSIGNED})
+	if seen := count(2, o.Handoff("TEST", []string{"101"})); seen[""] != 2 {
+		t.Errorf("not correct unassigned handoff %v", seen)
+	}
+	if seen := count(1, o.Handoff("OTHER", []string{"101"})); seen[""] != 1 {
+		t.Errorf("not correct handoff of unknown kind %v", seen)
+	}
+}
+
+func TestOwners_Concurrent(t *testing.T) {
+	o := NewOwners()
+	o.Add("TEST", "100", 1)
+	o.Configure(contract.KindConfig{Kind: "TEST", Strategy: contract.WEIGHTED})
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if owner := o.Assign("TEST", nil); owner == nil || *owner == "" {
+					t.Errorf("not correct owner")
+					return
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			owner := string(rune('a' + i))
+			for range 100 {
+				o.Add("TEST", owner, i+1)
+				o.Remove("TEST", owner)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestOwnerFromKey(t *testing.T) {
+	kind, owner, ok := OwnerFromKey("o-TEST-100")
+	if !ok || kind != "TEST" || owner != "100" {
+		t.Errorf("not correct owner key %v %v %v", kind, owner, ok)
+	}
+	if _, _, ok = OwnerFromKey("t-TEST-100"); ok {
+		t.Errorf("not correct task key as owner key")
+	}
+}
@@ -5,20 +5,26 @@ import (
 )
 
 type Playload struct {
-	data []contract.Event
+	data   []contract.Event
+	prefix string
 }
 
 func NewPlayload() *Playload {
 	return &Playload{data: []contract.Event{}}
 }
 
+// NewPrefixPlayload puts every key under prefix, the keyspace of a namespace.
+func NewPrefixPlayload(prefix string) *Playload {
+	return &Playload{data: []contract.Event{}, prefix: prefix}
+}
+
 func (p *Playload) Data() []contract.Event {
 	return p.data
 }
 
 func (p *Playload) Put(key []byte, value []byte) {
 	p.data = append(p.data, contract.Event{
-		Key:   key,
+		Key:   p.key(key),
 		Value: value,
 		Type:  contract.SetType,
 	})
@@ -26,8 +32,34 @@ func (p *Playload) Put(key []byte, value []byte) {
 
 func (p *Playload) Delete(key []byte, value []byte) {
 	p.data = append(p.data, contract.Event{
-		Key:   key,
+		Key:   p.key(key),
 		Value: value,
 		Type:  contract.DeleteType,
 	})
 }
+
+// Handoff gives the task key to the owner of the contract.TaskHandoff value
+// when it is applied.
+func (p *Playload) Handoff(key []byte, value []byte) {
+	p.data = append(p.data, contract.Event{
+		Key:   p.key(key),
+		Value: value,
+		Type:  contract.HandoffType,
+	})
+}
+
+// Rewind moves the offset key back to value when it is applied.
+func (p *Playload) Rewind(key []byte, value []byte) {
+	p.data = append(p.data, contract.Event{
+		Key:   p.key(key),
+		Value: value,
+		Type:  contract.RewindType,
+	})
+}
+
+func (p *Playload) key(key []byte) []byte {
+	if p.prefix == "" {
+		return key
+	}
+	return append([]byte(p.prefix), key...)
+}
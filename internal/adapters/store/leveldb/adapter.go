@@ -5,12 +5,31 @@ import (
 
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	level "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
+// reader reads the keyspace of a namespace.
+type reader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+	Has(key []byte, ro *opt.ReadOptions) (bool, error)
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+// LevelAdapter is the default namespace, the adapter of another namespace
+// reads its own keyspace and writes events with its prefix.
 type LevelAdapter struct {
-	db    *level.DB
-	tsid  *common.Tsid
-	kinds map[string]*common.RoundRobin
+	db       reader
+	level    *level.DB
+	prefix   string
+	spaces   *spaces
+	tsid     *common.Tsid
+	owners   *common.Owners
+	notifier *common.Notifier
+	events   *common.EventBus
+	quota    *quota
+	counts   *counts
 }
 
 func NewLevelAdapter(db *level.DB) (*LevelAdapter, error) {
@@ -18,9 +37,28 @@ func NewLevelAdapter(db *level.DB) (*LevelAdapter, error) {
 		return nil, errors.New("missing db")
 	}
 
-	return &LevelAdapter{
-		db:    db,
-		kinds: make(map[string]*common.RoundRobin),
-		tsid:  common.NewTsid(),
-	}, nil
+	adapter := &LevelAdapter{
+		db:       db,
+		level:    db,
+		owners:   common.NewOwners(),
+		tsid:     common.NewTsid(),
+		notifier: common.NewNotifier(),
+		events:   common.NewEventBus(eventsBuffer),
+		counts:   newCounts(),
+	}
+	adapter.spaces = newSpaces(adapter)
+	if err := adapter.loadOwners(); err != nil {
+		return nil, err
+	}
+	if err := adapter.counts.load(db); err != nil {
+		return nil, err
+	}
+	if err := adapter.spaces.load(); err != nil {
+		return nil, err
+	}
+	return adapter, nil
+}
+
+func (l LevelAdapter) payload() *common.Playload {
+	return common.NewPrefixPlayload(l.prefix)
 }
@@ -1,12 +1,20 @@
 package leveldb
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
 	"os"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/hashicorp/raft"
 	level "github.com/syndtr/goleveldb/leveldb"
 )
 
@@ -19,10 +27,10 @@ func TestLevelAdapter_Add(t *testing.T) {
 
 	groupIn := "12345"
 
-	_ = adapter.OwnerReg("100", []string{"TEST"})
-	_ = adapter.OwnerReg("101", []string{"TEST"})
-	_ = adapter.OwnerReg("102", []string{"TEST"})
-	_ = adapter.OwnerReg("103", []string{"TEST"})
+	_ = adapter.OwnerReg("100", []string{"TEST"}, 0)
+	_ = adapter.OwnerReg("101", []string{"TEST"}, 0)
+	_ = adapter.OwnerReg("102", []string{"TEST"}, 0)
+	_ = adapter.OwnerReg("103", []string{"TEST"}, 0)
 
 	for i := 1; i < 5; i++ {
 		p, err := adapter.Add(groupIn, "TEST", nil, map[string]string{"pid": groupIn, "status": "dead"})
@@ -52,7 +60,9 @@ func TestLevelAdapter_Pool(t *testing.T) {
 
 	groupIn := "12345"
 
-	_ = adapter.OwnerReg("100", []string{"TEST"})
+	if err = adapter.Apply(adapter.OwnerReg("100", []string{"TEST"}, 0)); err != nil {
+		t.Fatal(err)
+	}
 	var id string
 	for i := 1; i < 5; i++ {
 		p, err := adapter.Add(groupIn, "TEST", nil, map[string]string{"pid": groupIn, "status": "dead"})
@@ -84,7 +94,7 @@ func TestLevelAdapter_Pool(t *testing.T) {
 	}
 	adapter.Apply(p)
 
-	tasks, err := adapter.Pool("100", "TEST", 5)
+	tasks, err := adapter.Pool(context.Background(), "100", "TEST", 5)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -116,7 +126,7 @@ func TestLevelAdapter_UpdateFailed(t *testing.T) {
 
 	groupIn := "12345"
 
-	_ = adapter.OwnerReg("100", []string{"TEST"})
+	_ = adapter.OwnerReg("100", []string{"TEST"}, 0)
 	p, err := adapter.Add(groupIn, "TEST", nil, map[string]string{"pid": groupIn, "status": "dead"})
 	if err != nil {
 		t.Fatal(err)
@@ -186,6 +196,1002 @@ func TestLevelAdapter_GetFirstInGroup(t *testing.T) {
 	}
 }
 
+func TestLevelAdapter_SearchTaskCancelled(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := adapter.Add("12345", "TEST", nil, map[string]string{"pid": "12345"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = adapter.Apply(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = adapter.SearchTask(ctx, nil, nil, nil)
+	if err != context.Canceled {
+		t.Errorf("not correct cancelled search error %v", err)
+	}
+}
+
+func TestLevelAdapter_SearchTaskChunks(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range searchChunk*2 + 10 {
+		p, err := adapter.Add("12345", "TEST", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var chunks []int
+	size := uint(searchChunk + 5)
+	err = adapter.SearchTaskChunks(context.Background(), nil, nil, &size, func(tasks []contract.Task) error {
+		chunks = append(chunks, len(tasks))
+		return nil
+	})
+	if err != nil || !slices.Equal(chunks, []int{searchChunk, 5}) {
+		t.Errorf("not correct chunks %v %v", chunks, err)
+	}
+
+	stop := errors.New("stop")
+	chunks = nil
+	err = adapter.SearchTaskChunks(context.Background(), nil, nil, nil, func(tasks []contract.Task) error {
+		chunks = append(chunks, len(tasks))
+		return stop
+	})
+	if err != stop || len(chunks) != 1 {
+		t.Errorf("not correct stopped search %v %v", chunks, err)
+	}
+
+	size = 0
+	if tasks, err := adapter.SearchTask(context.Background(), nil, nil, &size); err != nil || len(tasks) != 0 {
+		t.Errorf("not correct search of size 0 %d %v", len(tasks), err)
+	}
+}
+
+func TestLevelAdapter_WatchFsmApply(t *testing.T) {
+	path, db, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed := adapter.Watch("TEST")
+
+	p, err := adapter.Add("12345", "TEST", nil, map[string]string{"pid": "12345"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := (*Fsm)(adapter).Apply(&raft.Log{Data: data}); res != nil {
+		t.Fatalf("not correct fsm apply %v", res)
+	}
+
+	_, err = db.Get(p[0].Key, nil)
+	if err != nil {
+		t.Errorf("task not applied by fsm")
+	}
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Errorf("watcher not notified by fsm apply")
+	}
+}
+
+func TestLevelAdapter_EventsFsmApply(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kind := "TEST"
+	_, ch, _, _, cancel := adapter.Subscribe(&contract.Condition{
+		Operations: []contract.Operation{{Operator: contract.Equal, Field: "kind", Value: kind}},
+	}, contract.EventCursor{})
+	defer cancel()
+
+	apply := func(index uint64, p []contract.Event) {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res := (*Fsm)(adapter).Apply(&raft.Log{Index: index, Data: data}); res != nil {
+			t.Fatalf("not correct fsm apply %v", res)
+		}
+	}
+
+	other, err := adapter.Add("1", "OTHER", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply(1, other)
+	p, err := adapter.Add("12345", kind, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply(2, p)
+	apply(3, []contract.Event{{Type: contract.DeleteType, Key: p[0].Key}})
+
+	for _, want := range []contract.TaskEventType{contract.TaskPut, contract.TaskDeleted} {
+		select {
+		case e := <-ch:
+			if e.Type != want || e.Task == nil || e.Task.Kind != kind || e.Task.Id != string(p[0].Key) {
+				t.Errorf("not correct event %+v", e)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("event %v not published by fsm apply", want)
+		}
+	}
+
+	backlog, _, _, gap, cancelResume := adapter.Subscribe(nil, contract.EventCursor{Index: 1, Offset: math.MaxInt})
+	cancelResume()
+	if gap || len(backlog) != 2 || backlog[0].Index != 2 || backlog[1].Index != 3 {
+		t.Errorf("not correct resume backlog %+v", backlog)
+	}
+}
+
+func TestLevelAdapter_WebhookOutbox(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := adapter.WebhookReg(contract.Webhook{Id: "w1", Url: "http://localhost", Kinds: []string{"TEST"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = adapter.Apply(p); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kind := range []string{"TEST", "OTHER"} {
+		p, err = adapter.Add("12345", kind, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+		id := string(p[0].Key)
+		p, err = adapter.Update(id, contract.COMPLETED, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deliveries, err := adapter.Outbox(context.Background(), time.Now(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("not correct count deliveries %d", len(deliveries))
+	}
+	d := deliveries[0]
+	if d.Webhook != "w1" || d.Status != contract.COMPLETED || d.Task.Kind != "TEST" {
+		t.Errorf("not correct delivery %+v", d)
+	}
+
+	d.Attempt = 1
+	d.Next = time.Now().Add(time.Minute)
+	p, err = adapter.WebhookRetry(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = adapter.Apply(p); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, _ = adapter.Outbox(context.Background(), time.Now(), 10)
+	if len(deliveries) != 0 {
+		t.Errorf("not correct delivery before backoff")
+	}
+
+	if err = adapter.Apply(adapter.WebhookDelivered(d.Id)); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, _ = adapter.Outbox(context.Background(), time.Now().Add(time.Hour), 10)
+	if len(deliveries) != 0 {
+		t.Errorf("not correct delivered outbox")
+	}
+}
+
+func TestLevelAdapter_Pause(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = adapter.Apply(adapter.OwnerReg("100", []string{"TEST"}, 0)); err != nil {
+		t.Fatal(err)
+	}
+	ids := []string{}
+	for _, group := range []string{"g1", "g2", "g3"} {
+		p, err := adapter.Add(group, "TEST", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, string(p[0].Key))
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pool := func() []contract.Task {
+		tasks, err := adapter.Pool(context.Background(), "100", "TEST", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tasks
+	}
+
+	apply(adapter.Pause("", "g1"))
+	apply(adapter.Update(ids[1], contract.PAUSED, nil, nil, nil))
+	if tasks := pool(); len(tasks) != 1 || tasks[0].Id != ids[2] {
+		t.Errorf("not correct pool of paused group and task %+v", tasks)
+	}
+	if id, _ := adapter.GetFirstInGroup("g1"); id != "" {
+		t.Errorf("not correct first in paused group")
+	}
+	if id, _ := adapter.GetFirstInGroup("g2"); id != "" {
+		t.Errorf("not correct first in group of paused task")
+	}
+
+	apply(adapter.Pause("TEST", ""))
+	if tasks := pool(); len(tasks) != 0 {
+		t.Errorf("not correct pool of paused kind")
+	}
+	if id, _ := adapter.GetFirstInGroup("g3"); id != "" {
+		t.Errorf("not correct first in group of paused kind")
+	}
+	if pauses, _ := adapter.Paused(); len(pauses) != 2 {
+		t.Errorf("not correct paused %+v", pauses)
+	}
+
+	apply(adapter.Resume("TEST", ""))
+	apply(adapter.Resume("", "g1"))
+	apply(adapter.Update(ids[1], contract.VIRGIN, nil, nil, nil))
+	if tasks := pool(); len(tasks) != 3 {
+		t.Errorf("not correct pool after resume %+v", tasks)
+	}
+
+	reason := "runaway"
+	apply(adapter.Update(ids[0], contract.CANCELLED, nil, &reason, nil))
+	cancelled, err := adapter.Get(strings.Replace(ids[0], common.PrefixTask, common.PrefixError, 1))
+	if err != nil || cancelled == nil || cancelled.Status != contract.CANCELLED || *cancelled.Error != reason {
+		t.Errorf("not correct cancelled record %+v", cancelled)
+	}
+	if id, _ := adapter.GetFirstInGroup("g1"); id != "" {
+		t.Errorf("not correct first in group of cancelled task")
+	}
+}
+
+func TestLevelAdapter_PoolOrdered(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	apply(adapter.OwnerReg("100", []string{"TEST"}, 0), nil)
+	apply(adapter.KindConfigSet(contract.KindConfig{Kind: "TEST", Ordered: true}))
+
+	ids := []string{}
+	for _, group := range []string{"g1", "g1", "g2", "g1"} {
+		p, err := adapter.Add(group, "TEST", nil, nil)
+		apply(p, err)
+		ids = append(ids, string(p[0].Key))
+	}
+	pool := func() (ids []string) {
+		tasks, err := adapter.Pool(context.Background(), "100", "TEST", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, task := range tasks {
+			ids = append(ids, task.Id)
+		}
+		return ids
+	}
+
+	if got := pool(); !slices.Equal(got, []string{ids[0], ids[2]}) {
+		t.Errorf("not correct ordered pool %v", got)
+	}
+	// the offset of a completed task does not hide the rest of the group
+	apply(adapter.Update(ids[2], contract.COMPLETED, nil, nil, &ids[2]))
+	apply(adapter.Update(ids[0], contract.COMPLETED, nil, nil, &ids[0]))
+	if got := pool(); !slices.Equal(got, []string{ids[1]}) {
+		t.Errorf("not correct ordered pool after complete %v", got)
+	}
+	errorTxt := "error"
+	apply(adapter.Update(ids[1], contract.FAILED, nil, &errorTxt, &ids[1]))
+	if got := pool(); !slices.Equal(got, []string{ids[3]}) {
+		t.Errorf("not correct ordered pool after fail %v", got)
+	}
+
+	apply(adapter.KindConfigSet(contract.KindConfig{Kind: "TEST"}))
+	if configs, _ := adapter.KindConfigs(); len(configs) != 0 {
+		t.Errorf("not correct removed kind config %+v", configs)
+	}
+}
+
+func TestLevelAdapter_InFlight(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{}
+	for i := 0; i < 3; i++ {
+		p, err := adapter.Add("12345", "TEST", nil, map[string]string{"n": "1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, string(p[0].Key))
+	}
+	for _, id := range ids[:2] {
+		p, err := adapter.Update(id, contract.SCHEDULED, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := adapter.InFlight(context.Background(), "TEST")
+	if err != nil || n != 2 {
+		t.Errorf("not correct in flight %d %v", n, err)
+	}
+	task, _ := adapter.Get(ids[0])
+	if task == nil || task.Status != contract.SCHEDULED || task.Param["n"] != "1" {
+		t.Errorf("not correct scheduled task %+v", task)
+	}
+}
+
+func TestLevelAdapter_OwnerExpire(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	apply(adapter.OwnerReg("100", []string{"TEST", "SOLO"}, 0), nil)
+	apply(adapter.OwnerReg("101", []string{"TEST"}, 0), nil)
+	apply(adapter.OwnerReg("102", []string{"TEST"}, 0), nil)
+	apply(adapter.OwnerHeartbeat("100", now.Add(-time.Second)))
+	apply(adapter.OwnerHeartbeat("101", now.Add(time.Minute)))
+	apply(adapter.OwnerHeartbeat("102", now.Add(-time.Second)))
+
+	owner := "100"
+	ids := []string{}
+	for _, kind := range []string{"TEST", "TEST", "SOLO"} {
+		p, err := adapter.Add("12345", kind, &owner, nil)
+		apply(p, err)
+		ids = append(ids, string(p[0].Key))
+	}
+	apply(adapter.Update(ids[1], contract.SCHEDULED, nil, nil, nil))
+	receiver := "101"
+	p, err := adapter.Add("12345", "TEST", &receiver, nil)
+	apply(p, err)
+	// the offset of the receiver moves past the tasks to hand over
+	offset := string(p[0].Key)
+	apply(adapter.Update(offset, contract.COMPLETED, nil, nil, &offset))
+	if tasks, err := adapter.Pool(context.Background(), "101", "TEST", 10); err != nil || len(tasks) != 0 {
+		t.Errorf("not correct pool before expire %+v %v", tasks, err)
+	}
+
+	expired, err := adapter.ExpiredOwners(now)
+	if err != nil || !slices.Equal(expired, []string{"100", "102"}) {
+		t.Errorf("not correct expired owners %v %v", expired, err)
+	}
+	apply(adapter.OwnerExpire("100", now))
+
+	for _, id := range ids[:2] {
+		task, _ := adapter.Get(id)
+		if task == nil || task.Owner == nil || *task.Owner != "101" || task.Status != contract.VIRGIN {
+			t.Errorf("not correct handed over task %+v", task)
+		}
+	}
+	if task, _ := adapter.Get(ids[2]); task == nil || *task.Owner != "100" {
+		t.Errorf("not correct task of kind without live owners %+v", task)
+	}
+	if tasks, err := adapter.Pool(context.Background(), "101", "TEST", 10); err != nil || len(tasks) != 2 {
+		t.Errorf("not correct pool of receiver %+v %v", tasks, err)
+	}
+	owners, _ := adapter.Owners()
+	for _, o := range owners {
+		if o.Owner == "100" {
+			t.Errorf("not correct expired owner still registered %+v", o)
+		}
+	}
+	if expired, _ = adapter.ExpiredOwners(now); !slices.Equal(expired, []string{"102"}) {
+		t.Errorf("not correct expired owners after expire %v", expired)
+	}
+}
+
+func TestLevelAdapter_OwnerUnReg(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kinds := func(owner string) []string {
+		owners, err := adapter.Owners()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, o := range owners {
+			if o.Owner == owner {
+				return o.Kinds
+			}
+		}
+		return nil
+	}
+
+	apply(adapter.OwnerReg("100", []string{"TEST", "SOLO"}, 0), nil)
+	apply(adapter.OwnerReg("101", []string{"TEST"}, 0), nil)
+	apply(adapter.OwnerReg("102", []string{"TEST"}, 0), nil)
+	owner := "100"
+	ids := []string{}
+	for _, kind := range []string{"TEST", "TEST", "SOLO"} {
+		p, err := adapter.Add("12345", kind, &owner, nil)
+		apply(p, err)
+		ids = append(ids, string(p[0].Key))
+	}
+
+	apply(adapter.OwnerUnReg("102", "", false))
+	if k := kinds("102"); k != nil {
+		t.Errorf("not correct kinds of unregistered owner %v", k)
+	}
+	if k := kinds("100"); len(k) != 2 {
+		t.Errorf("not correct kinds of other owner %v", k)
+	}
+
+	apply(adapter.OwnerUnReg("100", "TEST", true))
+	if k := kinds("100"); !slices.Equal(k, []string{"SOLO"}) {
+		t.Errorf("not correct kinds of owner unregistered from kind %v", k)
+	}
+	for _, id := range ids[:2] {
+		if task, _ := adapter.Get(id); task == nil || *task.Owner != "101" {
+			t.Errorf("not correct handed over task %+v", task)
+		}
+	}
+	if task, _ := adapter.Get(ids[2]); task == nil || *task.Owner != "100" {
+		t.Errorf("not correct task of kind still registered %+v", task)
+	}
+
+	apply(adapter.OwnerUnReg("101", "", false))
+	for _, id := range ids[:2] {
+		if task, _ := adapter.Get(id); task == nil || *task.Owner != "101" {
+			t.Errorf("not correct task unregistered without handoff %+v", task)
+		}
+	}
+}
+
+func TestLevelAdapter_OwnerUnRegHandoffRace(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	apply(adapter.OwnerReg("100", []string{"TEST"}, 0), nil)
+	apply(adapter.OwnerReg("101", []string{"TEST"}, 0), nil)
+	owner := "100"
+	ids := []string{}
+	for range 3 {
+		p, err := adapter.Add("12345", "TEST", &owner, nil)
+		apply(p, err)
+		ids = append(ids, string(p[0].Key))
+	}
+
+	// the tasks change between building and applying the handoff
+	handoff, err := adapter.OwnerUnReg("100", "TEST", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply(adapter.Update(ids[0], contract.VIRGIN, map[string]string{"step": "2"}, nil, nil))
+	apply(adapter.Delete(ids[1]))
+	other := "102"
+	task, _ := adapter.Get(ids[2])
+	task.Owner = &other
+	b, _ := json.Marshal(task)
+	apply([]contract.Event{{Type: contract.SetType, Key: []byte(ids[2]), Value: b}}, nil)
+	keyOffset := []byte(common.PrefixOffset + "-101-TEST")
+	apply([]contract.Event{{Type: contract.SetType, Key: keyOffset, Value: []byte(ids[2])}}, nil)
+	apply(handoff, nil)
+
+	if task, _ := adapter.Get(ids[0]); task == nil || *task.Owner != "101" || task.Param["step"] != "2" {
+		t.Errorf("not correct handed over task changed meanwhile %+v", task)
+	}
+	if task, _ := adapter.Get(ids[1]); task != nil {
+		t.Errorf("not correct task deleted meanwhile %+v", task)
+	}
+	if task, _ := adapter.Get(ids[2]); task == nil || *task.Owner != "102" {
+		t.Errorf("not correct task taken by another owner meanwhile %+v", task)
+	}
+	if offset, _ := adapter.db.Get(keyOffset, nil); string(offset) != ids[0] {
+		t.Errorf("not correct offset of receiver moved meanwhile %s", offset)
+	}
+}
+
+func TestLevelAdapter_OwnersRegistry(t *testing.T) {
+	path, db, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	add := func() string {
+		p, err := adapter.Add("12345", "TEST", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+		task, _ := adapter.Get(string(p[0].Key))
+		if task == nil || task.Owner == nil {
+			return ""
+		}
+		return *task.Owner
+	}
+
+	if err = adapter.Apply(adapter.OwnerReg("100", []string{"TEST"}, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if owner := add(); owner != "100" {
+		t.Errorf("not correct owner %v", owner)
+	}
+
+	// registered after the first task of the kind
+	if err = adapter.Apply(adapter.OwnerReg("101", []string{"TEST"}, 0)); err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for range 2 {
+		seen[add()] = true
+	}
+	if !seen["100"] || !seen["101"] {
+		t.Errorf("not correct owners of new tasks %v", seen)
+	}
+
+	p, err := adapter.OwnerUnReg("100", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := (*Fsm)(adapter).Apply(&raft.Log{Data: data}); res != nil {
+		t.Fatal(res)
+	}
+	for range 2 {
+		if owner := add(); owner != "101" {
+			t.Errorf("not correct owner after unregistration %v", owner)
+		}
+	}
+
+	reopened, err := NewLevelAdapter(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := reopened.KindOwners()
+	if len(kinds) != 1 || kinds[0].Kind != "TEST" || !slices.Equal(kinds[0].Owners, []string{"101"}) {
+		t.Errorf("not correct loaded owners %+v", kinds)
+	}
+}
+
+func TestLevelAdapter_Strategies(t *testing.T) {
+	path, db, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add := func(param map[string]string) (string, *string) {
+		p, err := adapter.Add("12345", "TEST", nil, param)
+		apply(p, err)
+		task, _ := adapter.Get(string(p[0].Key))
+		return string(p[0].Key), task.Owner
+	}
+
+	apply(adapter.OwnerReg("100", []string{"TEST"}, 2), nil)
+	apply(adapter.OwnerReg("101", []string{"TEST"}, 0), nil)
+	owner := "100"
+	for range 4 {
+		p, err := adapter.Add("12345", "TEST", &owner, nil)
+		apply(p, err)
+	}
+
+	// 100 has 4 tasks per weight 2, 101 gets the next 2
+	apply(adapter.KindConfigSet(contract.KindConfig{Kind: "TEST", Strategy: contract.LEAST_OUTSTANDING}))
+	ids := []string{}
+	for range 2 {
+		id, o := add(nil)
+		if o == nil || *o != "101" {
+			t.Errorf("not correct least outstanding owner %v", o)
+		}
+		ids = append(ids, id)
+	}
+	// completed tasks of 101 are not outstanding
+	for _, id := range ids {
+		apply(adapter.Update(id, contract.COMPLETED, nil, nil, nil))
+	}
+	if _, o := add(nil); o == nil || *o != "101" {
+		t.Errorf("not correct least outstanding owner after complete %v", o)
+	}
+
+	apply(adapter.KindConfigSet(contract.KindConfig{Kind: "TEST", Strategy: contract.HASH, HashParam: "customer"}))
+	_, first := add(map[string]string{"customer": "42"})
+	for range 3 {
+		if _, o := add(map[string]string{"customer": "42"}); first == nil || o == nil || *o != *first {
+			t.Errorf("not correct sticky owner %v %v", first, o)
+		}
+	}
+
+	apply(adapter.KindConfigSet(contract.KindConfig{Kind: "TEST", Strategy: contract.UNASSIGNED}))
+	if _, o := add(nil); o != nil {
+		t.Errorf("not correct unassigned owner %v", *o)
+	}
+	if _, err = adapter.Pool(context.Background(), "100", "TEST", 100); err != nil {
+		t.Errorf("not correct pool with unassigned task %v", err)
+	}
+
+	reopened, err := NewLevelAdapter(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := reopened.KindOwners()
+	if len(kinds) != 1 || !slices.Equal(kinds[0].Weights, []int{2, 1}) || kinds[0].Strategy != contract.UNASSIGNED {
+		t.Errorf("not correct loaded owners %+v", kinds)
+	}
+}
+
+func TestLevelAdapter_TaskLoads(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	apply(adapter.OwnerReg("100", []string{"TEST"}, 0), nil)
+	apply(adapter.OwnerReg("101", []string{"TEST"}, 0), nil)
+	apply(adapter.KindConfigSet(contract.KindConfig{Kind: "TEST", Strategy: contract.LEAST_OUTSTANDING}))
+
+	// the task is added and handed over to 101 by the same entry
+	owner := "100"
+	p, err := adapter.Add("12345", "TEST", &owner, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task := contract.Task{}
+	if err = json.Unmarshal(p[0].Value, &task); err != nil {
+		t.Fatal(err)
+	}
+	owner = "101"
+	task.Owner = &owner
+	value, _ := json.Marshal(task)
+	events := append(p, contract.Event{Type: contract.SetType, Key: p[0].Key, Value: value})
+
+	load := map[string]int{}
+	added := 0
+	for _, l := range taskLoads(adapter.db, adapter.owners, events) {
+		load[l.owner] += l.delta
+		if l.added {
+			added++
+		}
+	}
+	if load["100"] != 0 || load["101"] != 1 || added != 1 {
+		t.Errorf("not correct loads of one entry %v added %d", load, added)
+	}
+
+	apply(events, nil)
+	p, err = adapter.Add("12345", "TEST", nil, nil)
+	apply(p, err)
+	if got, _ := adapter.Get(string(p[0].Key)); got.Owner == nil || *got.Owner != "100" {
+		t.Errorf("not correct least outstanding owner %v", got.Owner)
+	}
+}
+
+func TestLevelAdapter_Claim(t *testing.T) {
+	path, _, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	claim := func(owner string, size uint, steal bool) []contract.Task {
+		p, tasks, err := adapter.Claim(owner, "TEST", size, steal)
+		apply(p, err)
+		return tasks
+	}
+
+	// added before any owner registered
+	ids := []string{}
+	for range 3 {
+		p, err := adapter.Add("12345", "TEST", nil, nil)
+		apply(p, err)
+		ids = append(ids, string(p[0].Key))
+	}
+	if tasks, _ := adapter.Pool(context.Background(), "100", "TEST", 10); len(tasks) != 0 {
+		t.Errorf("not correct pool of tasks without owner %+v", tasks)
+	}
+
+	tasks := claim("100", 2, false)
+	if len(tasks) != 2 || tasks[0].Id != ids[0] || *tasks[1].Owner != "100" {
+		t.Errorf("not correct claim %+v", tasks)
+	}
+	if tasks = claim("101", 2, false); len(tasks) != 1 || tasks[0].Id != ids[2] {
+		t.Errorf("not correct claim of the rest %+v", tasks)
+	}
+	if tasks = claim("102", 2, false); len(tasks) != 0 {
+		t.Errorf("not correct claim of claimed tasks %+v", tasks)
+	}
+	if tasks, _ := adapter.Pool(context.Background(), "100", "TEST", 10); len(tasks) != 2 {
+		t.Errorf("not correct pool of claimed tasks %+v", tasks)
+	}
+
+	owner := "101"
+	for range 5 {
+		p, err := adapter.Add("12345", "TEST", &owner, nil)
+		apply(p, err)
+	}
+	// started, 101 has 5 VIRGIN tasks and 102 none, 102 takes 2
+	apply(adapter.Update(ids[2], contract.SCHEDULED, nil, nil, nil))
+	if tasks = claim("102", 10, true); len(tasks) != 2 || *tasks[0].Owner != "102" || tasks[0].Id == ids[2] {
+		t.Errorf("not correct steal %+v", tasks)
+	}
+	if task, _ := adapter.Get(ids[2]); *task.Owner != "101" {
+		t.Errorf("not correct stolen started task %+v", task)
+	}
+	// 101 has 3, 100 and 102 have 2, nothing to even out
+	if tasks = claim("102", 10, true); len(tasks) != 0 {
+		t.Errorf("not correct steal from balanced owners %+v", tasks)
+	}
+}
+
+func TestLevelAdapter_Namespace(t *testing.T) {
+	path, db, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(events []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(events); err != nil {
+			t.Fatal(err)
+		}
+	}
+	apply(adapter.NamespaceCreate(contract.Namespace{Name: "a", MaxTasks: 2}))
+	apply(adapter.NamespaceCreate(contract.Namespace{Name: "b"}))
+	if _, err = adapter.NamespaceCreate(contract.Namespace{Name: "a-b"}); err == nil {
+		t.Errorf("not correct namespace name accepted")
+	}
+	a, err := adapter.Namespace("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := adapter.Namespace("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apply(a.OwnerReg("100", []string{"TEST"}, 0), nil)
+	p, err := a.Add("1", "TEST", nil, nil)
+	apply(p, err)
+	if !strings.HasPrefix(string(p[0].Key), "n-a-t-TEST-") {
+		t.Errorf("not correct key of namespace %s", p[0].Key)
+	}
+	apply(b.Add("1", "TEST", nil, nil))
+
+	tasks, err := a.Pool(context.Background(), "100", "TEST", 10)
+	if err != nil || len(tasks) != 1 || !strings.HasPrefix(tasks[0].Id, "t-TEST-") {
+		t.Errorf("not correct pool of namespace %+v %v", tasks, err)
+	}
+	if tasks, _ = b.SearchTask(context.Background(), nil, nil, nil); len(tasks) != 1 || tasks[0].Owner != nil {
+		t.Errorf("not correct owner registry of other namespace %+v", tasks)
+	}
+	if tasks, _ = adapter.SearchTask(context.Background(), nil, nil, nil); len(tasks) != 0 {
+		t.Errorf("not correct tasks of namespaces in default one %+v", tasks)
+	}
+	if task, err := a.Get(a.TaskId(p[0].Key)); err != nil || task == nil || !strings.HasPrefix(task.Id, "t-TEST-") {
+		t.Errorf("not correct get in namespace %v %v", task, err)
+	}
+
+	apply(a.Add("2", "TEST", nil, nil))
+	_, err = a.Add("3", "TEST", nil, nil)
+	if !errors.As(err, &contract.QuotaError{}) {
+		t.Errorf("not correct quota of tasks %v", err)
+	}
+	namespaces := adapter.Namespaces()
+	if len(namespaces) != 2 || namespaces[0].Usage.Tasks != 2 || namespaces[0].Usage.Bytes == 0 || namespaces[1].Usage.Tasks != 1 {
+		t.Errorf("not correct namespaces usage %+v", namespaces)
+	}
+	a.NamespaceRemoteUsage("b", contract.NamespaceUsage{Tasks: 5})
+	apply(adapter.NamespaceCreate(contract.Namespace{Name: "b", MaxTasks: 5}))
+	if _, err = b.Add("1", "TEST", nil, nil); !errors.As(err, &contract.QuotaError{}) {
+		t.Errorf("not correct quota with remote usage %v", err)
+	}
+
+	reopened, err := NewLevelAdapter(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if namespaces = reopened.Namespaces(); len(namespaces) != 2 || namespaces[0].Usage.Tasks != 2 {
+		t.Errorf("not correct namespaces loaded %+v", namespaces)
+	}
+
+	events, found, err := adapter.NamespaceDrop("a")
+	if !found {
+		t.Errorf("not correct namespace not found")
+	}
+	apply(events, err)
+	if _, err = adapter.Namespace("a"); !errors.Is(err, contract.ErrNamespaceNotFound) {
+		t.Errorf("not correct dropped namespace %v", err)
+	}
+	if _, err = adapter.NamespaceCreate(contract.Namespace{Name: "a"}); err == nil {
+		t.Errorf("not correct create of namespace being dropped")
+	}
+	for {
+		events, err := adapter.NamespacePurge("a", 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) == 0 {
+			break
+		}
+		apply(events, nil)
+	}
+	if _, found, _ = adapter.NamespaceDrop("a"); found {
+		t.Errorf("not correct keys left by purge")
+	}
+	apply(adapter.NamespaceCreate(contract.Namespace{Name: "a"}))
+	if a, err = adapter.Namespace("a"); err != nil {
+		t.Fatal(err)
+	}
+	if tasks, _ = a.SearchTask(context.Background(), nil, nil, nil); len(tasks) != 0 || a.KindOwners() != nil {
+		t.Errorf("not correct namespace created again %+v %+v", tasks, a.KindOwners())
+	}
+}
+
+func TestLevelAdapter_TaskCounts(t *testing.T) {
+	path, db, adapter, err := initLevelDb()
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(p []contract.Event, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = adapter.Apply(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ids := []string{}
+	for _, group := range []string{"g1", "g2", "g3"} {
+		p, err := adapter.Add(group, "TEST", nil, nil)
+		apply(p, err)
+		ids = append(ids, string(p[0].Key))
+	}
+	apply(adapter.Update(ids[0], contract.SCHEDULED, nil, nil, nil))
+	errorTxt := "error"
+	apply(adapter.Update(ids[1], contract.FAILED, nil, &errorTxt, nil))
+	apply(adapter.NamespaceCreate(contract.Namespace{Name: "a"}))
+	a, err := adapter.Namespace("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply(a.Add("g1", "TEST", nil, nil))
+
+	want := []contract.TaskCount{
+		{Kind: "TEST", Status: contract.VIRGIN, Count: 1},
+		{Kind: "TEST", Status: contract.SCHEDULED, Count: 1},
+		{Kind: "TEST", Status: contract.FAILED, Error: true, Count: 1},
+		{Namespace: "a", Kind: "TEST", Status: contract.VIRGIN, Count: 1},
+	}
+	if counts := adapter.TaskCounts(); !reflect.DeepEqual(counts, want) {
+		t.Errorf("not correct task counts %+v", counts)
+	}
+	reopened, err := NewLevelAdapter(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts := reopened.TaskCounts(); !reflect.DeepEqual(counts, want) {
+		t.Errorf("not correct loaded task counts %+v", counts)
+	}
+}
+
 func initLevelDb() (
 	path string,
 	db *level.DB,
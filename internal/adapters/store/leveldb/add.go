@@ -8,7 +8,6 @@ import (
 
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 func (l *LevelAdapter) Add(
@@ -17,6 +16,9 @@ func (l *LevelAdapter) Add(
 	owner *string,
 	param map[string]string,
 ) (events []contract.Event, err error) {
+	if err = l.quota.check(1); err != nil {
+		return events, err
+	}
 	task, id, keyGroup, err := l.newTask(group, kind, owner, param)
 	if err != nil {
 		return events, err
@@ -27,31 +29,26 @@ func (l *LevelAdapter) Add(
 		return events, fmt.Errorf("taskNew marshal error: %v", err)
 	}
 
-	payload := common.NewPlayload()
+	payload := l.payload()
 	payload.Put([]byte(id), []byte(taskBytes))
 	payload.Put([]byte(keyGroup), []byte(id))
 
 	return payload.Data(), err
 }
 
+// TaskId is the id of a task key, the keyspace of a namespace is not part of it.
+func (l *LevelAdapter) TaskId(key []byte) (id string) {
+	return strings.TrimPrefix(string(key), l.prefix)
+}
+
 func (l *LevelAdapter) newTask(
 	group string,
 	kind string,
 	owner *string,
 	param map[string]string,
 ) (task contract.Task, id string, keyGroup string, err error) {
-	rr, ok := l.kinds[kind]
-	if !ok {
-		owners, err := l.getOwnersKind(kind)
-		if err != nil {
-			return task, id, keyGroup, err
-		}
-		rr = common.NewRoundRobind(owners...)
-		l.kinds[kind] = rr
-	}
-
 	if owner == nil {
-		owner = rr.Get()
+		owner = l.owners.Assign(kind, param)
 	}
 	task = contract.Task{
 		Kind:   kind,
@@ -69,18 +66,3 @@ func (l *LevelAdapter) newTask(
 
 	return task, id, keyGroup, nil
 }
-
-func (l LevelAdapter) getOwnersKind(kind string) (owners []string, err error) {
-	prefix := common.PrefixOwner + "-" + kind + "-"
-	owners = []string{}
-	iter := l.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
-	for iter.Next() {
-		its := strings.Split(string(iter.Key()), "-")
-		if len(its) == 3 {
-			owners = append(owners, its[2])
-		}
-	}
-	iter.Release()
-	err = iter.Error()
-	return owners, err
-}
@@ -1,13 +1,165 @@
 package leveldb
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 	level "github.com/syndtr/goleveldb/leveldb"
 )
 
 // for compatibility with Raft consensus algorithm
 func (l LevelAdapter) Apply(events []contract.Event) (err error) {
-	return ApplyDb(l.db, events)
+	return l.spaces.apply(0, events, false)
+}
+
+// resolveEvents turns handoff and rewind events into the sets they make on
+// the state they are applied to, so a task changed since the events were made
+// is neither overwritten nor resurrected.
+func resolveEvents(db reader, events []contract.Event) (resolved []contract.Event, err error) {
+	// value of a key written by an earlier event, nil for a deleted one
+	written := make(map[string][]byte)
+	value := func(key []byte) []byte {
+		if v, ok := written[string(key)]; ok {
+			return v
+		}
+		v, err := db.Get(key, nil)
+		if err != nil {
+			return nil
+		}
+		return v
+	}
+	for _, e := range events {
+		switch e.Type {
+		case contract.HandoffType:
+			handoff := contract.TaskHandoff{}
+			if err = json.Unmarshal(e.Value, &handoff); err != nil {
+				return nil, fmt.Errorf("task handoff unmarshal error: %v", err)
+			}
+			v := value(e.Key)
+			if v == nil {
+				continue
+			}
+			task := contract.Task{}
+			if err = json.Unmarshal(v, &task); err != nil {
+				return nil, fmt.Errorf("task unmarshal error: %v", err)
+			}
+			if task.Owner == nil || *task.Owner != handoff.From {
+				continue
+			}
+			task.Owner = handoff.To
+			if task.Status == contract.SCHEDULED {
+				task.Status = contract.VIRGIN
+			}
+			if e.Value, err = json.Marshal(task); err != nil {
+				return nil, fmt.Errorf("task marshal error: %v", err)
+			}
+			e.Type = contract.SetType
+		case contract.RewindType:
+			if v := value(e.Key); v == nil || string(v) <= string(e.Value) {
+				continue
+			}
+			e.Type = contract.SetType
+		}
+		if e.Type == contract.SetType {
+			written[string(e.Key)] = e.Value
+		} else {
+			written[string(e.Key)] = nil
+		}
+		resolved = append(resolved, e)
+	}
+	return resolved, nil
+}
+
+// notifyTasks wakes pool waiters of every kind that got a task put or removed
+// or was resumed, a removed head lets the next task of an ordered group out.
+func notifyTasks(n *common.Notifier, events []contract.Event) {
+	for _, e := range events {
+		if e.Type == contract.DeleteType {
+			if kind, ok := kindFromResumeKey(string(e.Key)); ok {
+				n.Notify(kind)
+				continue
+			}
+		}
+		if kind, ok := common.KindFromTaskKey(string(e.Key)); ok {
+			n.Notify(kind)
+		}
+	}
+}
+
+type taskLoad struct {
+	kind  string
+	owner string
+	delta int
+	added bool
+}
+
+// taskLoads reads the outstanding tasks the events change for the kinds the
+// registry counts, before the events are applied.
+func taskLoads(db reader, owners *common.Owners, events []contract.Event) (loads []taskLoad) {
+	// value of a key written by an earlier event, nil for a deleted one
+	written := make(map[string][]byte)
+	for _, e := range events {
+		kind, ok := common.KindFromTaskKey(string(e.Key))
+		if !ok || !owners.Tracks(kind) {
+			continue
+		}
+		v, ok := written[string(e.Key)]
+		if !ok {
+			v, _ = db.Get(e.Key, nil)
+		}
+		old := contract.Task{}
+		if v != nil && json.Unmarshal(v, &old) == nil && outstanding(old) {
+			loads = append(loads, taskLoad{kind: kind, owner: *old.Owner, delta: -1})
+		}
+		var value []byte
+		if e.Type == contract.SetType {
+			value = e.Value
+		}
+		written[string(e.Key)] = value
+		task := contract.Task{}
+		if value != nil && json.Unmarshal(value, &task) == nil && outstanding(task) {
+			loads = append(loads, taskLoad{kind: kind, owner: *task.Owner, delta: 1, added: v == nil})
+		}
+	}
+	return loads
+}
+
+// syncOwners keeps the owner registry in line with the applied owner keys,
+// kind configs and tasks.
+func (l LevelAdapter) syncOwners(events []contract.Event, loads []taskLoad) error {
+	for _, e := range events {
+		if kind, owner, ok := common.OwnerFromKey(string(e.Key)); ok {
+			switch e.Type {
+			case contract.SetType:
+				l.owners.Add(kind, owner, ownerWeight(e.Value))
+			case contract.DeleteType:
+				l.owners.Remove(kind, owner)
+			}
+			continue
+		}
+		kind, ok := strings.CutPrefix(string(e.Key), common.PrefixKind+"-")
+		if !ok {
+			continue
+		}
+		config := contract.KindConfig{Kind: kind}
+		if e.Type == contract.SetType {
+			if err := json.Unmarshal(e.Value, &config); err != nil {
+				return fmt.Errorf("kind config unmarshal error: %v", err)
+			}
+		}
+		if l.owners.Configure(config) {
+			if err := l.loadKind(kind); err != nil {
+				return err
+			}
+		}
+	}
+	for _, load := range loads {
+		l.owners.Loaded(load.kind, load.owner, load.delta, load.added)
+	}
+	return nil
 }
 
 func ApplyDb(db *level.DB, events []contract.Event) error {
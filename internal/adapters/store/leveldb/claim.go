@@ -0,0 +1,126 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type claimable struct {
+	id   string
+	task contract.Task
+}
+
+// Claim gives owner up to size tasks of kind without an owner. With steal
+// it then takes VIRGIN tasks of the owner with the most of them, while that
+// owner keeps more than owner has. The claims are valid until another claim
+// of the kind is applied, the caller serializes them.
+func (l LevelAdapter) Claim(
+	owner string,
+	kind string,
+	size uint,
+	steal bool,
+) (events []contract.Event, tasks []contract.Task, err error) {
+	paused, err := l.isPaused(kind, "")
+	if err != nil || paused || size == 0 {
+		return nil, nil, err
+	}
+	config, err := l.KindConfig(kind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var free []claimable
+	// VIRGIN tasks of the other owners and the count of own ones
+	virgin := make(map[string][]claimable)
+	own := 0
+	pausedGroups := make(map[string]bool)
+	heads := make(map[string]string)
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixTask+"-"+kind+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		task := contract.Task{}
+		if err = json.Unmarshal(iter.Value(), &task); err != nil {
+			return nil, nil, fmt.Errorf("task unmarshal error: %v", err)
+		}
+		if task.Status != contract.VIRGIN {
+			continue
+		}
+		if task.Owner != nil && *task.Owner == owner {
+			own++
+			continue
+		}
+		if task.Owner != nil && !steal {
+			continue
+		}
+		paused, ok := pausedGroups[task.Group]
+		if !ok {
+			if paused, err = l.isPaused("", task.Group); err != nil {
+				return nil, nil, err
+			}
+			pausedGroups[task.Group] = paused
+		}
+		if paused {
+			continue
+		}
+		id := string(iter.Key())
+		if config.Ordered {
+			head, err := l.isGroupHead(heads, id, task.Group)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !head {
+				continue
+			}
+		}
+		c := claimable{id: id, task: task}
+		if task.Owner == nil {
+			if uint(len(free)) < size {
+				free = append(free, c)
+			}
+			continue
+		}
+		virgin[*task.Owner] = append(virgin[*task.Owner], c)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, nil, fmt.Errorf("could not get task keys: %v", err)
+	}
+
+	claimed := free
+	if steal && uint(len(claimed)) < size {
+		victim := ""
+		for o, vs := range virgin {
+			if victim == "" || len(vs) > len(virgin[victim]) || (len(vs) == len(virgin[victim]) && o < victim) {
+				victim = o
+			}
+		}
+		// take half of the difference, both end up with about the same
+		n := (len(virgin[victim]) - own) / 2
+		n = min(n, int(size)-len(claimed))
+		if victim != "" && n > 0 {
+			claimed = append(claimed, virgin[victim][:n]...)
+		}
+	}
+	if len(claimed) == 0 {
+		return nil, nil, nil
+	}
+
+	payload := l.payload()
+	first := claimed[0].id
+	for _, c := range claimed {
+		first = min(first, c.id)
+		c.task.Owner = &owner
+		b, err := json.Marshal(c.task)
+		if err != nil {
+			return nil, nil, fmt.Errorf("task marshal error: %v", err)
+		}
+		payload.Put([]byte(c.id), b)
+		c.task.Id = c.id
+		tasks = append(tasks, c.task)
+	}
+	l.rewindOffset(payload, owner, kind, first)
+	return payload.Data(), tasks, nil
+}
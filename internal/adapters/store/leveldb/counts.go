@@ -0,0 +1,143 @@
+package leveldb
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type countKey struct {
+	kind   string
+	status contract.Status
+	error  bool
+}
+
+// counts keeps the tasks of a namespace by kind and status, error tasks
+// apart, for the metrics.
+type counts struct {
+	mu    sync.Mutex
+	tasks map[countKey]int64
+}
+
+func newCounts() *counts {
+	return &counts{tasks: make(map[countKey]int64)}
+}
+
+// countTask is the part of a task the counts read.
+type countTask struct {
+	Kind   string          `json:"k"`
+	Status contract.Status `json:"s"`
+}
+
+func taskCountKey(key []byte, value []byte) (k countKey, ok bool) {
+	isError := bytes.HasPrefix(key, []byte(common.PrefixError+"-"))
+	if !isError && !bytes.HasPrefix(key, []byte(common.PrefixTask+"-")) {
+		return k, false
+	}
+	task := countTask{}
+	if json.Unmarshal(value, &task) != nil {
+		return k, false
+	}
+	return countKey{kind: task.Kind, status: task.Status, error: isError}, true
+}
+
+// load counts every task of the namespace.
+func (c *counts) load(db reader) error {
+	tasks := make(map[countKey]int64)
+	for _, prefix := range []string{common.PrefixTask, common.PrefixError} {
+		iter := db.NewIterator(util.BytesPrefix([]byte(prefix+"-")), nil)
+		for iter.Next() {
+			if k, ok := taskCountKey(iter.Key(), iter.Value()); ok {
+				tasks[k]++
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tasks = tasks
+	return nil
+}
+
+// count adds the change of events, it must run before the events are
+// written because the replaced tasks are read from db.
+func (c *counts) count(db reader, events []contract.Event) {
+	delta := make(map[countKey]int64)
+	// value of a key written by an earlier event, nil for a deleted one
+	written := make(map[string][]byte)
+	for _, e := range events {
+		if !isTaskKey(e.Key) {
+			continue
+		}
+		old, ok := written[string(e.Key)]
+		if !ok {
+			old, _ = db.Get(e.Key, nil)
+		}
+		if k, ok := taskCountKey(e.Key, old); old != nil && ok {
+			delta[k]--
+		}
+		var value []byte
+		if e.Type == contract.SetType {
+			value = e.Value
+		}
+		written[string(e.Key)] = value
+		if k, ok := taskCountKey(e.Key, value); value != nil && ok {
+			delta[k]++
+		}
+	}
+	if len(delta) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, n := range delta {
+		if c.tasks[k] += n; c.tasks[k] <= 0 {
+			delete(c.tasks, k)
+		}
+	}
+}
+
+func (c *counts) list(namespace string) (list []contract.TaskCount) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, n := range c.tasks {
+		list = append(list, contract.TaskCount{
+			Namespace: namespace,
+			Kind:      k.kind,
+			Status:    k.status,
+			Error:     k.error,
+			Count:     n,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Kind != list[j].Kind {
+			return list[i].Kind < list[j].Kind
+		}
+		if list[i].Error != list[j].Error {
+			return !list[i].Error
+		}
+		return list[i].Status < list[j].Status
+	})
+	return list
+}
+
+// TaskCounts are the tasks of this node in every namespace by kind and status.
+func (l *LevelAdapter) TaskCounts() (list []contract.TaskCount) {
+	list = l.counts.list("")
+	for _, ns := range l.Namespaces() {
+		if view := l.spaces.get(ns.Name); view != nil {
+			list = append(list, view.counts.list(ns.Name)...)
+		}
+	}
+	return list
+}
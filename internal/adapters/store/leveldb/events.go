@@ -0,0 +1,59 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"strings"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	eventsBuffer = 10000
+)
+
+func (l LevelAdapter) Subscribe(
+	condition *contract.Condition,
+	from contract.EventCursor,
+) (backlog []contract.TaskEvent, ch <-chan contract.TaskEvent, at contract.EventCursor, gap bool, cancel func()) {
+	return l.events.Subscribe(condition, from)
+}
+
+// taskEvents maps applied db events to task lifecycle events, it must run
+// before the events are written because deleted tasks are read from db.
+func taskEvents(db reader, index uint64, events []contract.Event) (res []contract.TaskEvent) {
+	for _, e := range events {
+		key := string(e.Key)
+		var typ contract.TaskEventType
+		switch {
+		case strings.HasPrefix(key, common.PrefixTask+"-"):
+			typ = contract.TaskPut
+			if e.Type == contract.DeleteType {
+				typ = contract.TaskDeleted
+			}
+		case strings.HasPrefix(key, common.PrefixError+"-"):
+			typ = contract.ErrorPut
+			if e.Type == contract.DeleteType {
+				typ = contract.ErrorDeleted
+			}
+		default:
+			continue
+		}
+
+		value := e.Value
+		if e.Type == contract.DeleteType {
+			v, err := db.Get(e.Key, nil)
+			if err != nil {
+				continue
+			}
+			value = v
+		}
+		task := contract.Task{}
+		if err := json.Unmarshal(value, &task); err != nil {
+			continue
+		}
+		task.Id = key
+		res = append(res, contract.TaskEvent{Index: index, Offset: len(res), Type: typ, Task: &task})
+	}
+	return res
+}
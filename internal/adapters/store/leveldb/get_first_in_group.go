@@ -2,10 +2,18 @@ package leveldb
 
 import (
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
+// GetFirstInGroup returns an empty id while the group, the kind or
+// the first task itself is paused.
 func (l LevelAdapter) GetFirstInGroup(group string) (id string, err error) {
+	paused, err := l.isPaused("", group)
+	if err != nil || paused {
+		return id, err
+	}
+
 	prefix := common.PrefixGroup + "-" + group + "-"
 	iter := l.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
 	if iter.Next() {
@@ -13,6 +21,20 @@ func (l LevelAdapter) GetFirstInGroup(group string) (id string, err error) {
 	}
 	iter.Release()
 	err = iter.Error()
+	if err != nil || id == "" {
+		return id, err
+	}
+
+	task, err := l.Get(id)
+	if err != nil || task == nil {
+		return id, err
+	}
+	if task.Status == contract.PAUSED {
+		return "", nil
+	}
+	if paused, err = l.isPaused(task.Kind, ""); err != nil || paused {
+		return "", err
+	}
 
 	return id, err
 }
@@ -0,0 +1,30 @@
+package leveldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// InFlight counts the SCHEDULED tasks of kind of all owners.
+func (l LevelAdapter) InFlight(ctx context.Context, kind string) (n int, err error) {
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixTask+"-"+kind+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		task := contract.Task{}
+		if err = json.Unmarshal(iter.Value(), &task); err != nil {
+			return n, fmt.Errorf("task unmarshal error: %v", err)
+		}
+		if task.Status == contract.SCHEDULED {
+			n++
+		}
+	}
+	return n, iter.Error()
+}
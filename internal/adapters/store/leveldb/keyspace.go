@@ -0,0 +1,54 @@
+package leveldb
+
+import (
+	level "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// keyspace reads the keys under prefix as if they had none.
+type keyspace struct {
+	db     *level.DB
+	prefix []byte
+}
+
+func (k keyspace) key(key []byte) []byte {
+	return append(append(make([]byte, 0, len(k.prefix)+len(key)), k.prefix...), key...)
+}
+
+func (k keyspace) Get(key []byte, ro *opt.ReadOptions) ([]byte, error) {
+	return k.db.Get(k.key(key), ro)
+}
+
+func (k keyspace) Has(key []byte, ro *opt.ReadOptions) (bool, error) {
+	return k.db.Has(k.key(key), ro)
+}
+
+func (k keyspace) NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator {
+	r := util.BytesPrefix(k.prefix)
+	if slice != nil && slice.Start != nil {
+		r.Start = k.key(slice.Start)
+	}
+	if slice != nil && slice.Limit != nil {
+		r.Limit = k.key(slice.Limit)
+	}
+	return keyspaceIterator{Iterator: k.db.NewIterator(r, ro), space: k}
+}
+
+type keyspaceIterator struct {
+	iterator.Iterator
+	space keyspace
+}
+
+func (i keyspaceIterator) Key() []byte {
+	key := i.Iterator.Key()
+	if key == nil {
+		return nil
+	}
+	return key[len(i.space.prefix):]
+}
+
+func (i keyspaceIterator) Seek(key []byte) bool {
+	return i.Iterator.Seek(i.space.key(key))
+}
@@ -0,0 +1,84 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func kindConfigKey(kind string) string {
+	return fmt.Sprintf("%s-%s", common.PrefixKind, kind)
+}
+
+// KindConfigSet replaces the config of a kind, a zero config removes it.
+func (l LevelAdapter) KindConfigSet(config contract.KindConfig) (events []contract.Event, err error) {
+	payload := l.payload()
+	if config == (contract.KindConfig{Kind: config.Kind}) {
+		payload.Delete([]byte(kindConfigKey(config.Kind)), nil)
+		return payload.Data(), nil
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("kind config marshal error: %v", err)
+	}
+	payload.Put([]byte(kindConfigKey(config.Kind)), b)
+	return payload.Data(), nil
+}
+
+func (l LevelAdapter) KindConfig(kind string) (config contract.KindConfig, err error) {
+	config.Kind = kind
+	v, err := l.db.Get([]byte(kindConfigKey(kind)), nil)
+	if err == errors.ErrNotFound {
+		return config, nil
+	}
+	if err != nil {
+		return config, fmt.Errorf("get kind config error: %v", err)
+	}
+	if err = json.Unmarshal(v, &config); err != nil {
+		return config, fmt.Errorf("kind config unmarshal error: %v", err)
+	}
+	return config, nil
+}
+
+func (l LevelAdapter) KindConfigs() (configs []contract.KindConfig, err error) {
+	configs = []contract.KindConfig{}
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixKind+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		c := contract.KindConfig{}
+		if err = json.Unmarshal(iter.Value(), &c); err != nil {
+			return nil, fmt.Errorf("kind config unmarshal error: %v", err)
+		}
+		configs = append(configs, c)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not get kind config keys: %v", err)
+	}
+	return configs, nil
+}
+
+// isGroupHead reports whether the task id is the first one of its group,
+// heads keeps the first key per group for the tasks of one scan.
+func (l LevelAdapter) isGroupHead(heads map[string]string, id string, group string) (bool, error) {
+	groupId, err := l.getGroupId(id, group)
+	if err != nil {
+		return false, err
+	}
+	head, ok := heads[group]
+	if !ok {
+		iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixGroup+"-"+group+"-")), nil)
+		if iter.Next() {
+			head = string(iter.Key())
+		}
+		iter.Release()
+		if err = iter.Error(); err != nil {
+			return false, err
+		}
+		heads[group] = head
+	}
+	return head == groupId, nil
+}
@@ -0,0 +1,440 @@
+package leveldb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func namespaceKey(name string) string {
+	return fmt.Sprintf("%s-%s", common.PrefixNamespace, name)
+}
+
+func keyspacePrefix(name string) string {
+	return fmt.Sprintf("%s-%s-", common.PrefixKeyspace, name)
+}
+
+// spaces holds the adapters of the namespaces, an adapter is kept when its
+// namespace is dropped and used again when it is created again.
+type spaces struct {
+	root  *LevelAdapter
+	mu    sync.RWMutex
+	views map[string]*LevelAdapter
+	live  map[string]bool
+}
+
+func newSpaces(root *LevelAdapter) *spaces {
+	return &spaces{
+		root:  root,
+		views: make(map[string]*LevelAdapter),
+		live:  make(map[string]bool),
+	}
+}
+
+// get returns the adapter of a namespace that is not dropped.
+func (s *spaces) get(name string) *LevelAdapter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.live[name] {
+		return nil
+	}
+	return s.views[name]
+}
+
+func (s *spaces) each(f func(view *LevelAdapter)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, view := range s.views {
+		f(view)
+	}
+}
+
+// load opens the namespaces of the records, after a snapshot restore as well.
+func (s *spaces) load() error {
+	records, err := s.root.namespaceRecords()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.live = make(map[string]bool)
+	s.mu.Unlock()
+	for _, ns := range records {
+		if err = s.open(ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// open takes the quotas of ns, a namespace that was not open loads its
+// owners, usage and task counts.
+func (s *spaces) open(ns contract.Namespace) error {
+	s.mu.Lock()
+	view, ok := s.views[ns.Name]
+	if !ok {
+		view = s.root.newSpace(ns.Name)
+		s.views[ns.Name] = view
+	}
+	opened := s.live[ns.Name]
+	s.live[ns.Name] = true
+	s.mu.Unlock()
+
+	view.quota.setLimit(ns)
+	if opened {
+		return nil
+	}
+	if err := view.loadOwners(); err != nil {
+		return err
+	}
+	view.quota.load(view.db)
+	return view.counts.load(view.db)
+}
+
+func (s *spaces) drop(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.live, name)
+}
+
+// sync opens and drops the namespaces of applied records.
+func (s *spaces) sync(events []contract.Event) error {
+	for _, e := range events {
+		name, ok := strings.CutPrefix(string(e.Key), common.PrefixNamespace+"-")
+		if !ok {
+			continue
+		}
+		if e.Type == contract.DeleteType {
+			s.drop(name)
+			continue
+		}
+		ns := contract.Namespace{}
+		if err := json.Unmarshal(e.Value, &ns); err != nil {
+			return fmt.Errorf("namespace unmarshal error: %v", err)
+		}
+		if err := s.open(ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spaceEvents are the events of one namespace without the prefix of their keys.
+type spaceEvents struct {
+	space     *LevelAdapter
+	events    []contract.Event
+	loads     []taskLoad
+	published []contract.TaskEvent
+}
+
+// split groups events by namespace, the keys of dropped namespaces are
+// written without updating any registry.
+func (s *spaces) split(events []contract.Event) (groups []spaceEvents) {
+	index := make(map[*LevelAdapter]int)
+	for _, e := range events {
+		space := s.root
+		if rest, ok := bytes.CutPrefix(e.Key, []byte(common.PrefixKeyspace+"-")); ok {
+			name, _, found := bytes.Cut(rest, []byte("-"))
+			if space = s.get(string(name)); !found || space == nil {
+				continue
+			}
+			e.Key = e.Key[len(space.prefix):]
+		}
+		i, ok := index[space]
+		if !ok {
+			i = len(groups)
+			index[space] = i
+			groups = append(groups, spaceEvents{space: space})
+		}
+		groups[i].events = append(groups[i].events, e)
+	}
+	return groups
+}
+
+// apply writes the events of all namespaces at once, the registries of
+// every namespace follow its own events. Task events are published with index.
+// Handoff and rewind events are resolved against the state first.
+func (s *spaces) apply(index uint64, events []contract.Event, publish bool) (err error) {
+	if events, err = resolveEvents(s.root.level, events); err != nil {
+		return err
+	}
+	groups := s.split(events)
+	for i := range groups {
+		g := &groups[i]
+		if publish {
+			g.published = taskEvents(g.space.db, index, g.events)
+		}
+		g.loads = taskLoads(g.space.db, g.space.owners, g.events)
+		g.space.quota.count(g.space.db, g.events)
+		g.space.counts.count(g.space.db, g.events)
+	}
+	if err := ApplyDb(s.root.level, events); err != nil {
+		return fmt.Errorf("failed to apply event: %v", err)
+	}
+	for _, g := range groups {
+		notifyTasks(g.space.notifier, g.events)
+		if err := g.space.syncOwners(g.events, g.loads); err != nil {
+			return err
+		}
+		if publish {
+			g.space.events.Publish(index, g.published)
+		}
+	}
+	return s.sync(events)
+}
+
+func (l *LevelAdapter) newSpace(name string) *LevelAdapter {
+	prefix := keyspacePrefix(name)
+	return &LevelAdapter{
+		db:       keyspace{db: l.level, prefix: []byte(prefix)},
+		level:    l.level,
+		prefix:   prefix,
+		spaces:   l.spaces,
+		tsid:     l.tsid,
+		owners:   common.NewOwners(),
+		notifier: common.NewNotifier(),
+		events:   common.NewEventBus(eventsBuffer),
+		quota:    &quota{},
+		counts:   newCounts(),
+	}
+}
+
+// Namespace returns the adapter of a namespace, the empty one is the default namespace.
+func (l LevelAdapter) Namespace(name string) (*LevelAdapter, error) {
+	if name == "" {
+		return l.spaces.root, nil
+	}
+	view := l.spaces.get(name)
+	if view == nil {
+		return nil, fmt.Errorf("%w: %v", contract.ErrNamespaceNotFound, name)
+	}
+	return view, nil
+}
+
+func (l LevelAdapter) HasNamespace(name string) bool {
+	return l.spaces.get(name) != nil
+}
+
+// Namespaces lists the namespaces with their usage on this node.
+func (l LevelAdapter) Namespaces() (namespaces []contract.Namespace) {
+	namespaces = []contract.Namespace{}
+	l.spaces.mu.RLock()
+	for name := range l.spaces.live {
+		namespaces = append(namespaces, l.spaces.views[name].quota.namespace())
+	}
+	l.spaces.mu.RUnlock()
+	slices.SortFunc(namespaces, func(a, b contract.Namespace) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return namespaces
+}
+
+// NamespaceCreate creates a namespace or changes the quotas of an existing one.
+func (l LevelAdapter) NamespaceCreate(ns contract.Namespace) (events []contract.Event, err error) {
+	if !contract.ValidNamespace(ns.Name) {
+		return nil, fmt.Errorf("invalid namespace %q", ns.Name)
+	}
+	if ns.MaxTasks < 0 || ns.MaxBytes < 0 {
+		return nil, fmt.Errorf("negative quota of namespace %v", ns.Name)
+	}
+	if l.spaces.get(ns.Name) == nil {
+		used, err := l.keyspaceUsed(ns.Name)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return nil, fmt.Errorf("namespace %v is being dropped", ns.Name)
+		}
+	}
+	ns.Usage = contract.NamespaceUsage{}
+	b, err := json.Marshal(ns)
+	if err != nil {
+		return nil, fmt.Errorf("namespace marshal error: %v", err)
+	}
+	payload := common.NewPlayload()
+	payload.Put([]byte(namespaceKey(ns.Name)), b)
+	return payload.Data(), nil
+}
+
+// NamespaceDrop removes the record of a namespace, its keys are removed by
+// NamespacePurge. It reports whether there was anything to drop.
+func (l LevelAdapter) NamespaceDrop(name string) (events []contract.Event, found bool, err error) {
+	if !contract.ValidNamespace(name) {
+		return nil, false, fmt.Errorf("invalid namespace %q", name)
+	}
+	if l.spaces.get(name) == nil {
+		found, err = l.keyspaceUsed(name)
+		return nil, found, err
+	}
+	payload := common.NewPlayload()
+	payload.Delete([]byte(namespaceKey(name)), nil)
+	return payload.Data(), true, nil
+}
+
+// NamespacePurge removes up to size keys of a dropped namespace, no events
+// are left when every key is removed.
+func (l LevelAdapter) NamespacePurge(name string, size int) (events []contract.Event, err error) {
+	if !contract.ValidNamespace(name) {
+		return nil, fmt.Errorf("invalid namespace %q", name)
+	}
+	if l.spaces.get(name) != nil {
+		return nil, fmt.Errorf("namespace %v is not dropped", name)
+	}
+	payload := common.NewPlayload()
+	iter := l.level.NewIterator(util.BytesPrefix([]byte(keyspacePrefix(name))), nil)
+	defer iter.Release()
+	for len(payload.Data()) < size && iter.Next() {
+		payload.Delete(bytes.Clone(iter.Key()), nil)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not get namespace keys: %v", err)
+	}
+	return payload.Data(), nil
+}
+
+// NamespaceRemoteUsage sets the usage of the other nodes the quotas of a
+// namespace are checked with.
+func (l LevelAdapter) NamespaceRemoteUsage(name string, usage contract.NamespaceUsage) {
+	if view := l.spaces.get(name); view != nil {
+		view.quota.setRemote(usage)
+	}
+}
+
+func (l LevelAdapter) keyspaceUsed(name string) (bool, error) {
+	iter := l.level.NewIterator(util.BytesPrefix([]byte(keyspacePrefix(name))), nil)
+	defer iter.Release()
+	used := iter.Next()
+	if err := iter.Error(); err != nil {
+		return false, fmt.Errorf("could not get namespace keys: %v", err)
+	}
+	return used, nil
+}
+
+func (l LevelAdapter) namespaceRecords() (records []contract.Namespace, err error) {
+	iter := l.level.NewIterator(util.BytesPrefix([]byte(common.PrefixNamespace+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		ns := contract.Namespace{}
+		if err = json.Unmarshal(iter.Value(), &ns); err != nil {
+			return nil, fmt.Errorf("namespace unmarshal error: %v", err)
+		}
+		records = append(records, ns)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not get namespace keys: %v", err)
+	}
+	return records, nil
+}
+
+// quota keeps the usage of a namespace on this node and of the other nodes
+// as last refreshed. Tasks in flight to the raft log are not counted yet,
+// so a quota can be exceeded by the tasks added at the same time.
+type quota struct {
+	mu     sync.Mutex
+	limit  contract.Namespace
+	local  contract.NamespaceUsage
+	remote contract.NamespaceUsage
+}
+
+func (q *quota) setLimit(ns contract.Namespace) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limit = ns
+}
+
+func (q *quota) setRemote(usage contract.NamespaceUsage) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.remote = usage
+}
+
+// namespace is the limit with the usage of this node.
+func (q *quota) namespace() contract.Namespace {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	ns := q.limit
+	ns.Usage = q.local
+	return ns
+}
+
+// check fails when n more tasks exceed a quota, the default namespace has none.
+func (q *quota) check(n int64) error {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.limit.MaxTasks > 0 && q.local.Tasks+q.remote.Tasks+n > q.limit.MaxTasks {
+		return contract.QuotaError{Msg: fmt.Sprintf("namespace %v exceeds its quota of %d tasks", q.limit.Name, q.limit.MaxTasks)}
+	}
+	if q.limit.MaxBytes > 0 && q.local.Bytes+q.remote.Bytes >= q.limit.MaxBytes {
+		return contract.QuotaError{Msg: fmt.Sprintf("namespace %v exceeds its quota of %d bytes", q.limit.Name, q.limit.MaxBytes)}
+	}
+	return nil
+}
+
+// load counts the usage of every key of the namespace.
+func (q *quota) load(db reader) {
+	usage := contract.NamespaceUsage{}
+	iter := db.NewIterator(nil, nil)
+	for iter.Next() {
+		usage.Bytes += int64(len(iter.Key()) + len(iter.Value()))
+		if isTaskKey(iter.Key()) {
+			usage.Tasks++
+		}
+	}
+	iter.Release()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.local = usage
+}
+
+// count adds the change of events to the usage, it must run before the
+// events are written because the replaced values are read from db.
+func (q *quota) count(db reader, events []contract.Event) {
+	if q == nil {
+		return
+	}
+	delta := contract.NamespaceUsage{}
+	// size of a key written by an earlier event, -1 for a deleted one
+	written := make(map[string]int)
+	for _, e := range events {
+		old, ok := written[string(e.Key)]
+		if !ok {
+			old = -1
+			if v, err := db.Get(e.Key, nil); err == nil {
+				old = len(e.Key) + len(v)
+			}
+		}
+		size := -1
+		if e.Type == contract.SetType {
+			size = len(e.Key) + len(e.Value)
+		}
+		written[string(e.Key)] = size
+		delta.Bytes += int64(max(size, 0) - max(old, 0))
+		if !isTaskKey(e.Key) {
+			continue
+		}
+		if old < 0 && size >= 0 {
+			delta.Tasks++
+		} else if old >= 0 && size < 0 {
+			delta.Tasks--
+		}
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.local.Tasks += delta.Tasks
+	q.local.Bytes += delta.Bytes
+}
+
+func isTaskKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(common.PrefixTask+"-")) || bytes.HasPrefix(key, []byte(common.PrefixError+"-"))
+}
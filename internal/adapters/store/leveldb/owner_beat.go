@@ -0,0 +1,84 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func ownerBeatKey(owner string) string {
+	return fmt.Sprintf("%s-%s", common.PrefixBeat, owner)
+}
+
+// OwnerHeartbeat keeps the owner alive until expires, a zero expires makes
+// the owner live forever.
+func (l LevelAdapter) OwnerHeartbeat(owner string, expires time.Time) (events []contract.Event, err error) {
+	if expires.IsZero() {
+		payload := l.payload()
+		payload.Delete([]byte(ownerBeatKey(owner)), nil)
+		return payload.Data(), nil
+	}
+	b, err := json.Marshal(contract.OwnerBeat{Owner: owner, Expires: expires})
+	if err != nil {
+		return nil, fmt.Errorf("owner beat marshal error: %v", err)
+	}
+	payload := l.payload()
+	payload.Put([]byte(ownerBeatKey(owner)), b)
+	return payload.Data(), nil
+}
+
+// ExpiredOwners returns the owners whose last heartbeat expired before now,
+// owners registered without a ttl never expire.
+func (l LevelAdapter) ExpiredOwners(now time.Time) (owners []string, err error) {
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixBeat+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		beat := contract.OwnerBeat{}
+		if err = json.Unmarshal(iter.Value(), &beat); err != nil {
+			return nil, fmt.Errorf("owner beat unmarshal error: %v", err)
+		}
+		if beat.Expires.Before(now) {
+			owners = append(owners, beat.Owner)
+		}
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not get owner beat keys: %v", err)
+	}
+	return owners, nil
+}
+
+func (l LevelAdapter) isExpired(owner string, now time.Time) (bool, error) {
+	v, err := l.db.Get([]byte(ownerBeatKey(owner)), nil)
+	if err == errors.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get owner beat error: %v", err)
+	}
+	beat := contract.OwnerBeat{}
+	if err = json.Unmarshal(v, &beat); err != nil {
+		return false, fmt.Errorf("owner beat unmarshal error: %v", err)
+	}
+	return beat.Expires.Before(now), nil
+}
+
+// OwnerExpire unregisters an expired owner and hands its tasks over to the
+// live owners of their kinds. Tasks of a kind without live owners keep the owner.
+func (l LevelAdapter) OwnerExpire(owner string, now time.Time) (events []contract.Event, err error) {
+	kinds, err := l.ownerKinds(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := l.payload()
+	payload.Delete([]byte(ownerBeatKey(owner)), nil)
+	if err = l.ownerUnReg(payload, owner, kinds, true, now); err != nil {
+		return nil, err
+	}
+	return payload.Data(), nil
+}
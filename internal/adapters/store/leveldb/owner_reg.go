@@ -2,6 +2,7 @@ package leveldb
 
 import (
 	"fmt"
+	"strconv"
 
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
@@ -10,11 +11,16 @@ import (
 func (l LevelAdapter) OwnerReg(
 	owner string,
 	kinds []string,
+	weight int,
 ) (events []contract.Event) {
-	payload := common.NewPlayload()
+	var value []byte
+	if weight > 1 {
+		value = []byte(strconv.Itoa(weight))
+	}
+	payload := l.payload()
 	for _, itr := range kinds {
 		keyOwner := fmt.Sprintf("%s-%s-%s", common.PrefixOwner, itr, owner)
-		payload.Put([]byte(keyOwner), nil)
+		payload.Put([]byte(keyOwner), value)
 	}
 	events = payload.Data()
 
@@ -1,30 +1,157 @@
 package leveldb
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
-func (l LevelAdapter) OwnerUnReg(owner string) (events []contract.Event, err error) {
-	prefix := common.PrefixOwner + "-"
-	var keys = []string{}
-	iter := l.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+// OwnerUnReg unregisters owner from kind or from every kind when kind is empty.
+// With handoff the tasks of the owner go to the live owners of their kind by
+// its strategy, otherwise they wait for the owner to register again.
+func (l LevelAdapter) OwnerUnReg(owner string, kind string, handoff bool) (events []contract.Event, err error) {
+	kinds, err := l.ownerKinds(owner)
+	if err != nil {
+		return nil, err
+	}
+	if kind != "" {
+		kinds = slices.DeleteFunc(kinds, func(k string) bool { return k != kind })
+	}
+
+	payload := l.payload()
+	if err = l.ownerUnReg(payload, owner, kinds, handoff, time.Now()); err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		payload.Delete([]byte(ownerBeatKey(owner)), nil)
+	}
+	return payload.Data(), nil
+}
+
+func (l LevelAdapter) ownerUnReg(
+	payload *common.Playload,
+	owner string,
+	kinds []string,
+	handoff bool,
+	now time.Time,
+) error {
+	for _, kind := range kinds {
+		payload.Delete([]byte(fmt.Sprintf("%s-%s-%s", common.PrefixOwner, kind, owner)), nil)
+		if !handoff {
+			continue
+		}
+
+		config, err := l.KindConfig(kind)
+		if err != nil {
+			return err
+		}
+		// tasks of an UNASSIGNED kind go back to be claimed by any owner
+		var owners []string
+		if config.Strategy != contract.UNASSIGNED {
+			if owners, err = l.liveOwners(kind, owner, now); err != nil {
+				return err
+			}
+			if len(owners) == 0 {
+				continue
+			}
+		}
+		if err = l.handoffTasks(payload, owner, kind, l.owners.Handoff(kind, owners)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownerKinds returns the kinds the owner is registered for.
+func (l LevelAdapter) ownerKinds(owner string) (kinds []string, err error) {
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixOwner+"-")), nil)
+	defer iter.Release()
 	for iter.Next() {
-		keys = append(keys, string(iter.Key()))
+		its := strings.Split(string(iter.Key()), "-")
+		if len(its) == 3 && its[2] == owner {
+			kinds = append(kinds, its[1])
+		}
 	}
-	iter.Release()
-	err = iter.Error()
-	if err != nil {
+	if err = iter.Error(); err != nil {
 		return nil, fmt.Errorf("could not get owner keys: %v", err)
 	}
+	return kinds, nil
+}
 
-	payload := common.NewPlayload()
-	for _, key := range keys {
-		payload.Delete([]byte(key), nil)
+// liveOwners returns the owners of kind except owner that did not expire.
+func (l LevelAdapter) liveOwners(kind string, owner string, now time.Time) (live []string, err error) {
+	for _, o := range l.owners.Owners(kind) {
+		if o == owner {
+			continue
+		}
+		expired, err := l.isExpired(o, now)
+		if err != nil {
+			return nil, err
+		}
+		if !expired {
+			live = append(live, o)
+		}
 	}
+	return live, nil
+}
+
+// handoffTasks gives the tasks of kind owned by owner to the owners of assign,
+// a nil owner leaves the task without an owner. The tasks are handed over
+// when applied, so a task changed or deleted meanwhile is not written back.
+// Scheduled tasks are started again, the offsets of the receivers are moved
+// back so their pools see the tasks and the offset of the owner is removed.
+func (l LevelAdapter) handoffTasks(
+	payload *common.Playload,
+	owner string,
+	kind string,
+	assign func(param map[string]string) *string,
+) error {
+	first := make(map[string]string)
+
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixTask+"-"+kind+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		task := contract.Task{}
+		if err := json.Unmarshal(iter.Value(), &task); err != nil {
+			return fmt.Errorf("task unmarshal error: %v", err)
+		}
+		if task.Owner == nil || *task.Owner != owner {
+			continue
+		}
+		handoff := contract.TaskHandoff{From: owner, To: assign(task.Param)}
+		b, err := json.Marshal(handoff)
+		if err != nil {
+			return fmt.Errorf("task handoff marshal error: %v", err)
+		}
+		id := string(iter.Key())
+		payload.Handoff([]byte(id), b)
+		if handoff.To == nil {
+			continue
+		}
+		if _, ok := first[*handoff.To]; !ok {
+			first[*handoff.To] = id
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("could not get task keys: %v", err)
+	}
+
+	for receiver, id := range first {
+		l.rewindOffset(payload, receiver, kind, id)
+	}
+	payload.Delete([]byte(fmt.Sprintf("%s-%s-%s", common.PrefixOffset, owner, kind)), nil)
+	return nil
+}
 
-	return payload.Data(), err
+// rewindOffset moves the pool offset of owner back to id when applied, so
+// its pool sees the tasks it got from id on.
+func (l LevelAdapter) rewindOffset(payload *common.Playload, owner string, kind string, id string) {
+	keyOffset := fmt.Sprintf("%s-%s-%s", common.PrefixOffset, owner, kind)
+	payload.Rewind([]byte(keyOffset), []byte(id))
 }
@@ -0,0 +1,103 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// KindOwners lists the owners of every kind the tasks are assigned to.
+func (l LevelAdapter) KindOwners() (kinds []contract.KindOwners) {
+	return l.owners.KindOwners()
+}
+
+// loadOwners fills the owner registry from the owner keys and kind configs.
+func (l LevelAdapter) loadOwners() error {
+	kinds := make(map[string]map[string]int)
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixOwner+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		if kind, owner, ok := common.OwnerFromKey(string(iter.Key())); ok {
+			if kinds[kind] == nil {
+				kinds[kind] = make(map[string]int)
+			}
+			kinds[kind][owner] = ownerWeight(iter.Value())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("could not get owner keys: %v", err)
+	}
+	configs, err := l.KindConfigs()
+	if err != nil {
+		return err
+	}
+	for _, kind := range l.owners.Reset(kinds, configs) {
+		if err = l.loadKind(kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadKind counts the outstanding tasks per owner of kind.
+func (l LevelAdapter) loadKind(kind string) error {
+	load := make(map[string]int)
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixTask+"-"+kind+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		task := contract.Task{}
+		if err := json.Unmarshal(iter.Value(), &task); err != nil {
+			return fmt.Errorf("task unmarshal error: %v", err)
+		}
+		if outstanding(task) {
+			load[*task.Owner]++
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("could not get task keys: %v", err)
+	}
+	l.owners.SetLoad(kind, load)
+	return nil
+}
+
+func outstanding(task contract.Task) bool {
+	return task.Owner != nil && (task.Status == contract.VIRGIN || task.Status == contract.SCHEDULED)
+}
+
+// ownerWeight reads the weight stored in an owner key, empty is 1.
+func ownerWeight(value []byte) int {
+	weight, err := strconv.Atoi(string(value))
+	if err != nil {
+		return 1
+	}
+	return weight
+}
+
+func (l LevelAdapter) Owners() (owners []contract.OwnerKinds, err error) {
+	byOwner := make(map[string]int)
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixOwner+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		its := strings.Split(string(iter.Key()), "-")
+		if len(its) != 3 {
+			continue
+		}
+		kind, owner := its[1], its[2]
+		i, ok := byOwner[owner]
+		if !ok {
+			i = len(owners)
+			byOwner[owner] = i
+			owners = append(owners, contract.OwnerKinds{Owner: owner})
+		}
+		owners[i].Kinds = append(owners[i].Kinds, kind)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not get owner keys: %v", err)
+	}
+	return owners, nil
+}
@@ -0,0 +1,87 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	pauseKind  = "k"
+	pauseGroup = "g"
+)
+
+// pauseKey is p-k-{kind} for a kind or p-g-{group} for a group.
+func pauseKey(kind string, group string) (string, error) {
+	if (kind == "") == (group == "") {
+		return "", errors.New("pause needs a kind or a group")
+	}
+	if kind != "" {
+		return fmt.Sprintf("%s-%s-%s", common.PrefixPause, pauseKind, kind), nil
+	}
+	return fmt.Sprintf("%s-%s-%s", common.PrefixPause, pauseGroup, group), nil
+}
+
+func (l LevelAdapter) Pause(kind string, group string) (events []contract.Event, err error) {
+	key, err := pauseKey(kind, group)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(contract.Pause{Kind: kind, Group: group, Ts: time.Now()})
+	if err != nil {
+		return nil, fmt.Errorf("pause marshal error: %v", err)
+	}
+	payload := l.payload()
+	payload.Put([]byte(key), b)
+	return payload.Data(), nil
+}
+
+func (l LevelAdapter) Resume(kind string, group string) (events []contract.Event, err error) {
+	key, err := pauseKey(kind, group)
+	if err != nil {
+		return nil, err
+	}
+	payload := l.payload()
+	payload.Delete([]byte(key), nil)
+	return payload.Data(), nil
+}
+
+func (l LevelAdapter) Paused() (pauses []contract.Pause, err error) {
+	pauses = []contract.Pause{}
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixPause+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		p := contract.Pause{}
+		if err = json.Unmarshal(iter.Value(), &p); err != nil {
+			return nil, fmt.Errorf("pause unmarshal error: %v", err)
+		}
+		pauses = append(pauses, p)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not get pause keys: %v", err)
+	}
+	return pauses, nil
+}
+
+func (l LevelAdapter) isPaused(kind string, group string) (bool, error) {
+	key, err := pauseKey(kind, group)
+	if err != nil {
+		return false, err
+	}
+	ok, err := l.db.Has([]byte(key), nil)
+	if err != nil {
+		return false, fmt.Errorf("get pause error: %v", err)
+	}
+	return ok, nil
+}
+
+// kindFromResumeKey extracts the kind of a deleted pause key p-k-{kind}.
+func kindFromResumeKey(key string) (kind string, ok bool) {
+	return strings.CutPrefix(key, common.PrefixPause+"-"+pauseKind+"-")
+}
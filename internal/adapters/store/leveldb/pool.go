@@ -1,6 +1,7 @@
 package leveldb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -11,34 +12,75 @@ import (
 )
 
 func (l LevelAdapter) Pool(
+	ctx context.Context,
 	owner string,
 	kind string,
 	size uint,
 ) (tasks []contract.Task, err error) {
 	tasks = make([]contract.Task, 0)
+	paused, err := l.isPaused(kind, "")
+	if err != nil || paused {
+		return tasks, err
+	}
+	config, err := l.KindConfig(kind)
+	if err != nil {
+		return tasks, err
+	}
+	pausedGroups := make(map[string]bool)
+	heads := make(map[string]string)
 	prefix := common.PrefixTask + "-" + kind + "-"
 	r := util.BytesPrefix([]byte(prefix))
 
-	keyOffset := fmt.Sprintf("%s-%s-%s", common.PrefixOffset, owner, kind)
-	startId, err := l.db.Get([]byte(keyOffset), nil)
-	if err != nil && err != errors.ErrNotFound {
-		return tasks, fmt.Errorf("task get offset error: %v", err)
-	}
-	if err != errors.ErrNotFound {
-		r.Start = startId
+	// in ordered mode a task behind the offset becomes the head of its group later,
+	// a limited kind leases tasks out of order
+	if !config.Ordered && config.MaxInFlight == 0 && config.Rate == 0 {
+		keyOffset := fmt.Sprintf("%s-%s-%s", common.PrefixOffset, owner, kind)
+		startId, err := l.db.Get([]byte(keyOffset), nil)
+		if err != nil && err != errors.ErrNotFound {
+			return tasks, fmt.Errorf("task get offset error: %v", err)
+		}
+		if err != errors.ErrNotFound {
+			r.Start = startId
+		}
 	}
 
 	iter := l.db.NewIterator(r, nil)
+	defer iter.Release()
 
 out:
 	for iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return tasks, err
+		}
 		task := contract.Task{}
 		err := json.Unmarshal(iter.Value(), &task)
 		if err != nil {
 			return tasks, fmt.Errorf("task unmarshal error: %v", err)
 		}
-		if owner == *task.Owner {
+		if task.Status == contract.PAUSED {
+			continue
+		}
+		paused, ok := pausedGroups[task.Group]
+		if !ok {
+			if paused, err = l.isPaused("", task.Group); err != nil {
+				return tasks, err
+			}
+			pausedGroups[task.Group] = paused
+		}
+		if paused {
+			continue
+		}
+		if task.Owner != nil && owner == *task.Owner {
 			task.Id = string(iter.Key())
+			if config.Ordered {
+				head, err := l.isGroupHead(heads, task.Id, task.Group)
+				if err != nil {
+					return tasks, err
+				}
+				if !head {
+					continue
+				}
+			}
 			tasks = append(tasks, task)
 			size--
 		}
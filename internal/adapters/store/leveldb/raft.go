@@ -14,18 +14,18 @@ import (
 type Fsm LevelAdapter
 
 func (f *Fsm) Apply(l *raft.Log) any {
-	var event contract.Event
-	if err := json.Unmarshal(l.Data, &event); err != nil {
+	var events []contract.Event
+	if err := json.Unmarshal(l.Data, &events); err != nil {
 		return fmt.Errorf("event marshal error: %v", err)
 	}
-	if err := ApplyDb(f.db, []contract.Event{event}); err != nil {
-		return fmt.Errorf("failed to apply event: %v", err)
+	if err := f.spaces.apply(l.Index, events, true); err != nil {
+		return err
 	}
 	return nil
 }
 
 func (f *Fsm) Snapshot() (raft.FSMSnapshot, error) {
-	s, err := f.db.GetSnapshot()
+	s, err := f.level.GetSnapshot()
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +33,8 @@ func (f *Fsm) Snapshot() (raft.FSMSnapshot, error) {
 }
 
 func (f *Fsm) Restore(rc io.ReadCloser) error {
+	f.spaces.each(func(view *LevelAdapter) { view.events.Reset() })
+	f.events.Reset()
 	sr := sds.NewReader(rc)
 	var batch leveldb.Batch
 	for {
@@ -49,16 +51,22 @@ func (f *Fsm) Restore(rc io.ReadCloser) error {
 		}
 		batch.Put(key, value)
 		if batch.Len() == 1000 {
-			if err := f.db.Write(&batch, nil); err != nil {
+			if err := f.level.Write(&batch, nil); err != nil {
 				return err
 			}
 			batch.Reset()
 		}
 	}
-	if err := f.db.Write(&batch, nil); err != nil {
+	if err := f.level.Write(&batch, nil); err != nil {
 		return err
 	}
-	return nil
+	if err := (*LevelAdapter)(f).loadOwners(); err != nil {
+		return err
+	}
+	if err := f.counts.load(f.db); err != nil {
+		return err
+	}
+	return f.spaces.load()
 }
 
 type FsmSnapshot struct {
@@ -1,14 +1,31 @@
 package leveldb
 
 import (
+	"context"
+
 	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
 func (l LevelAdapter) SearchErrorTask(
+	ctx context.Context,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 ) (tasks []contract.Task, err error) {
-	return l.searchTask(condition, common.PrefixError, kind, size)
+	return collectTasks(func(send func(tasks []contract.Task) error) error {
+		return l.searchTask(ctx, condition, common.PrefixError, kind, size, send)
+	})
+}
+
+// SearchErrorTaskChunks hands the found error tasks to send chunk by chunk
+// while the tasks are read.
+func (l LevelAdapter) SearchErrorTaskChunks(
+	ctx context.Context,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) error {
+	return l.searchTask(ctx, condition, common.PrefixError, kind, size, send)
 }
@@ -1,6 +1,7 @@
 package leveldb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -9,44 +10,93 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
+// searchChunk is the count of found tasks handed to the caller at once
+const searchChunk = 100
+
 func (l LevelAdapter) SearchTask(
+	ctx context.Context,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 ) (tasks []contract.Task, err error) {
-	return l.searchTask(condition, common.PrefixTask, kind, size)
+	return collectTasks(func(send func(tasks []contract.Task) error) error {
+		return l.searchTask(ctx, condition, common.PrefixTask, kind, size, send)
+	})
+}
+
+// SearchTaskChunks hands the found tasks to send chunk by chunk while the
+// tasks are read.
+func (l LevelAdapter) SearchTaskChunks(
+	ctx context.Context,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) error {
+	return l.searchTask(ctx, condition, common.PrefixTask, kind, size, send)
 }
 
-func (l LevelAdapter) searchTask(condition *contract.Condition, prefixTask string, kind *string, size *uint) (tasks []contract.Task, err error) {
+func collectTasks(search func(send func(tasks []contract.Task) error) error) (tasks []contract.Task, err error) {
 	tasks = make([]contract.Task, 0)
+	err = search(func(chunk []contract.Task) error {
+		tasks = append(tasks, chunk...)
+		return nil
+	})
+	return tasks, err
+}
+
+func (l LevelAdapter) searchTask(
+	ctx context.Context,
+	condition *contract.Condition,
+	prefixTask string,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) (err error) {
+	// the caller's size is shared by concurrent fan-out calls, count on a copy
+	var left *uint
+	if size != nil {
+		n := *size
+		left = &n
+	}
 	prefix := prefixTask + "-"
 	if kind != nil {
 		prefix = prefix + *kind + "-"
 	}
 	r := util.BytesPrefix([]byte(prefix))
 	iter := l.db.NewIterator(r, nil)
+	defer iter.Release()
 
-out:
-	for iter.Next() {
+	chunk := make([]contract.Task, 0, searchChunk)
+	for (left == nil || *left > 0) && iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		task := contract.Task{}
 		err := json.Unmarshal(iter.Value(), &task)
 		if err != nil {
-			return tasks, fmt.Errorf("task unmarshal error: %v", err)
+			return fmt.Errorf("task unmarshal error: %v", err)
 		}
-		if condition == nil || common.ConditionCalculateTask(&task, condition) {
-			task.Id = string(iter.Key())
-			tasks = append(tasks, task)
-			if size != nil {
-				*size--
-			}
+		if condition != nil && !common.ConditionCalculateTask(&task, condition) {
+			continue
+		}
+		task.Id = string(iter.Key())
+		chunk = append(chunk, task)
+		if left != nil {
+			*left--
 		}
-		if size != nil && *size == 0 {
-			break out
+		if len(chunk) == searchChunk {
+			if err = send(chunk); err != nil {
+				return err
+			}
+			chunk = make([]contract.Task, 0, searchChunk)
 		}
 	}
-
-	iter.Release()
-	err = iter.Error()
-
-	return tasks, err
+	if err = iter.Error(); err != nil {
+		return err
+	}
+	if len(chunk) > 0 {
+		return send(chunk)
+	}
+	return nil
 }
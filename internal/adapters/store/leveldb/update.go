@@ -25,20 +25,23 @@ func (l LevelAdapter) Update(
 		return
 	}
 
-	task.Param = param
+	// marking a task scheduled keeps its params when none are sent
+	if param != nil || status != contract.SCHEDULED {
+		task.Param = param
+	}
 	task.Status = status
 
-	payload := common.NewPlayload()
+	payload := l.payload()
 
 	switch status {
-	case contract.SCHEDULED:
-	case contract.VIRGIN:
+	case contract.VIRGIN, contract.SCHEDULED, contract.PAUSED:
 		taskBytes, err := json.Marshal(task)
 		if err != nil {
 			return nil, fmt.Errorf("task marshal error: %v", err)
 		}
 		payload.Put([]byte(id), taskBytes)
-	case contract.FAILED:
+	case contract.FAILED, contract.CANCELLED:
+		// a cancelled task is kept with the error tasks as a record
 		taskError := contract.Task{
 			Id: strings.Replace(
 				id,
@@ -46,11 +49,12 @@ func (l LevelAdapter) Update(
 				common.PrefixError,
 				1,
 			),
-			Kind:  task.Kind,
-			Group: task.Group,
-			Param: task.Param,
-			Error: error,
-			Ts:    time.Now(),
+			Kind:   task.Kind,
+			Group:  task.Group,
+			Status: status,
+			Param:  task.Param,
+			Error:  error,
+			Ts:     time.Now(),
 		}
 
 		groupId, err := l.getGroupId(id, taskError.Group)
@@ -64,6 +68,9 @@ func (l LevelAdapter) Update(
 		payload.Delete([]byte(groupId), nil)
 		payload.Delete([]byte(id), nil)
 		payload.Put([]byte(taskError.Id), taskBytes)
+		if err = l.webhookDeliveries(payload, taskError, status); err != nil {
+			return nil, err
+		}
 	case contract.COMPLETED:
 		groupId, err := l.getGroupId(id, task.Group)
 		if err != nil {
@@ -71,6 +78,9 @@ func (l LevelAdapter) Update(
 		}
 		payload.Delete([]byte(groupId), nil)
 		payload.Delete([]byte(id), nil)
+		if err = l.webhookDeliveries(payload, *task, status); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unexpected status: %v", status)
 	}
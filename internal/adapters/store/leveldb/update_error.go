@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 )
 
@@ -24,10 +23,10 @@ func (l LevelAdapter) UpdateError(
 	taskError.Param = param
 	taskError.Status = status
 
-	payload := common.NewPlayload()
+	payload := l.payload()
 
 	switch status {
-	case contract.FAILED:
+	case contract.FAILED, contract.CANCELLED:
 		taskBytes, err := json.Marshal(taskError)
 		if err != nil {
 			return nil, fmt.Errorf("task marshal error: %v", err)
@@ -50,6 +49,9 @@ func (l LevelAdapter) UpdateError(
 		payload.Delete([]byte(id), nil)
 	case contract.COMPLETED:
 		payload.Delete([]byte(id), nil)
+		if err = l.webhookDeliveries(payload, *taskError, status); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unexpected status: %v", status)
 	}
@@ -0,0 +1,6 @@
+package leveldb
+
+// Watch returns a channel closed when a task of kind is applied.
+func (l LevelAdapter) Watch(kind string) <-chan struct{} {
+	return l.notifier.Watch(kind)
+}
@@ -0,0 +1,144 @@
+package leveldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	common "github.com/esaseleznev/taskstoredb/internal/adapters/store/common"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func (l LevelAdapter) WebhookReg(webhook contract.Webhook) (events []contract.Event, err error) {
+	b, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, fmt.Errorf("webhook marshal error: %v", err)
+	}
+	payload := l.payload()
+	payload.Put([]byte(webhookKey(webhook.Id)), b)
+	return payload.Data(), nil
+}
+
+// WebhookUnReg drops the subscription, its pending deliveries are dropped by the dispatcher.
+func (l LevelAdapter) WebhookUnReg(id string) (events []contract.Event) {
+	payload := l.payload()
+	payload.Delete([]byte(webhookKey(id)), nil)
+	return payload.Data()
+}
+
+func (l LevelAdapter) Webhook(id string) (webhook *contract.Webhook, err error) {
+	v, err := l.db.Get([]byte(webhookKey(id)), nil)
+	if err == errors.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook from db error: %v", err)
+	}
+	webhook = &contract.Webhook{}
+	if err = json.Unmarshal(v, webhook); err != nil {
+		return nil, fmt.Errorf("webhook unmarshal error: %v", err)
+	}
+	return webhook, nil
+}
+
+func (l LevelAdapter) Webhooks() (webhooks []contract.Webhook, err error) {
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixWebhook+"-")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		webhook := contract.Webhook{}
+		if err = json.Unmarshal(iter.Value(), &webhook); err != nil {
+			return nil, fmt.Errorf("webhook unmarshal error: %v", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("iterator error: %v", err)
+	}
+	return webhooks, nil
+}
+
+// Outbox returns deliveries due at now, oldest first.
+func (l LevelAdapter) Outbox(ctx context.Context, now time.Time, size int) (deliveries []contract.WebhookDelivery, err error) {
+	iter := l.db.NewIterator(util.BytesPrefix([]byte(common.PrefixOutbox+"-")), nil)
+	defer iter.Release()
+	for iter.Next() && len(deliveries) < size {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		d := contract.WebhookDelivery{}
+		if err = json.Unmarshal(iter.Value(), &d); err != nil {
+			return nil, fmt.Errorf("delivery unmarshal error: %v", err)
+		}
+		if d.Next.After(now) {
+			continue
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("iterator error: %v", err)
+	}
+	return deliveries, nil
+}
+
+func (l LevelAdapter) WebhookDelivered(id string) (events []contract.Event) {
+	payload := l.payload()
+	payload.Delete([]byte(id), nil)
+	return payload.Data()
+}
+
+func (l LevelAdapter) WebhookRetry(delivery contract.WebhookDelivery) (events []contract.Event, err error) {
+	b, err := json.Marshal(delivery)
+	if err != nil {
+		return nil, fmt.Errorf("delivery marshal error: %v", err)
+	}
+	payload := l.payload()
+	payload.Put([]byte(delivery.Id), b)
+	return payload.Data(), nil
+}
+
+// webhookDeliveries adds an outbox entry for every webhook subscribed to
+// the task reaching status, it is written by the same raft entry as the task.
+func (l LevelAdapter) webhookDeliveries(payload *common.Playload, task contract.Task, status contract.Status) error {
+	webhooks, err := l.Webhooks()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, w := range webhooks {
+		if !webhookMatch(w, task.Kind, status) {
+			continue
+		}
+		d := contract.WebhookDelivery{
+			Id:      fmt.Sprintf("%s-%s", common.PrefixOutbox, l.tsid.Next(now.UnixMilli())),
+			Webhook: w.Id,
+			Status:  status,
+			Task:    task,
+			Next:    now,
+		}
+		d.Task.Status = status
+		b, err := json.Marshal(d)
+		if err != nil {
+			return fmt.Errorf("delivery marshal error: %v", err)
+		}
+		payload.Put([]byte(d.Id), b)
+	}
+	return nil
+}
+
+func webhookMatch(w contract.Webhook, kind string, status contract.Status) bool {
+	if len(w.Kinds) > 0 && !slices.Contains(w.Kinds, kind) {
+		return false
+	}
+	if len(w.Statuses) == 0 {
+		return status == contract.COMPLETED || status == contract.FAILED
+	}
+	return slices.Contains(w.Statuses, status)
+}
+
+func webhookKey(id string) string {
+	return fmt.Sprintf("%s-%s", common.PrefixWebhook, id)
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	IdHeader        = "X-Webhook-Id"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+type HttpSender struct {
+	client *http.Client
+}
+
+func NewHttpSender(timeout time.Duration) HttpSender {
+	return HttpSender{client: &http.Client{Timeout: timeout}}
+}
+
+// Send posts the event signed with secret, any status but 2xx is a failed delivery.
+func (s HttpSender) Send(ctx context.Context, url string, secret string, event contract.WebhookEvent) (err error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("webhook event marshal error: %v", err)
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook request error: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(IdHeader, event.Id)
+	req.Header.Set(TimestampHeader, ts)
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, ts, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook response status %v", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign is the hex HMAC-SHA256 of "{timestamp}.{body}", the timestamp lets
+// receivers reject replayed deliveries.
+func Sign(secret string, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
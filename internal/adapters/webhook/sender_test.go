@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestHttpSender_Send(t *testing.T) {
+	secret := "secret"
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	s := NewHttpSender(time.Second)
+	err := s.Send(context.Background(), srv.URL, secret, contract.WebhookEvent{Id: "x-1", Webhook: "w1", Status: contract.COMPLETED})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Header.Get(IdHeader) != "x-1" {
+		t.Errorf("not correct id header")
+	}
+	sign := Sign(secret, got.Header.Get(TimestampHeader), body)
+	if got.Header.Get(SignatureHeader) != sign {
+		t.Errorf("not correct signature %v", got.Header.Get(SignatureHeader))
+	}
+}
+
+func TestHttpSender_SendFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	s := NewHttpSender(time.Second)
+	if err := s.Send(context.Background(), srv.URL, "", contract.WebhookEvent{Id: "x-1"}); err == nil {
+		t.Errorf("not correct error for failed response")
+	}
+}
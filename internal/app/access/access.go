@@ -0,0 +1,238 @@
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// Operation is what a caller does with the tasks of a kind.
+type Operation string
+
+const (
+	ADD             Operation = "add"
+	UPDATE          Operation = "update"
+	GET             Operation = "get"
+	POOL            Operation = "pool"
+	SEARCH          Operation = "search"
+	SEARCH_DELETE   Operation = "search_delete"
+	SEARCH_UPDATE   Operation = "search_update"
+	OWNER_REG       Operation = "owner_reg"
+	OWNER_UNREG     Operation = "owner_unreg"
+	OWNER_HEARTBEAT Operation = "owner_heartbeat"
+	OWNER_LIST      Operation = "owner_list"
+	PAUSE           Operation = "pause"
+	KIND_CONFIG     Operation = "kind_config"
+	KIND_LIST       Operation = "kind_list"
+	WEBHOOK_REG     Operation = "webhook_reg"
+	WEBHOOK_UNREG   Operation = "webhook_unreg"
+	WEBHOOK_LIST    Operation = "webhook_list"
+	EVENTS          Operation = "events"
+	STATUS          Operation = "status"
+	NAMESPACE       Operation = "namespace"
+	ANY             Operation = "*"
+)
+
+var operations = []Operation{
+	ADD, UPDATE, GET, POOL, SEARCH, SEARCH_DELETE, SEARCH_UPDATE,
+	OWNER_REG, OWNER_UNREG, OWNER_HEARTBEAT, OWNER_LIST, PAUSE, KIND_CONFIG, KIND_LIST,
+	WEBHOOK_REG, WEBHOOK_UNREG, WEBHOOK_LIST, EVENTS, STATUS, NAMESPACE, ANY,
+}
+
+// Principal is the caller of a request.
+type Principal struct {
+	Subject string
+	Roles   []string
+	// Cluster is a node of the cluster calling with the cluster credential.
+	Cluster bool
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the caller of an authenticated request.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// Internal marks the call of a handler by another one that was allowed already.
+func Internal(ctx context.Context) context.Context {
+	return WithPrincipal(ctx, Principal{Subject: "internal", Cluster: true})
+}
+
+// Rule allows operations on the kinds matching a pattern of path.Match,
+// a rule without kinds matches every kind. Namespaces limit the rule the
+// same way, the pattern "" is the default namespace.
+type Rule struct {
+	Operations []Operation `json:"operations"`
+	Kinds      []string    `json:"kinds,omitempty"`
+	Namespaces []string    `json:"namespaces,omitempty"`
+}
+
+// Policy grants the rules of roles to subjects, a subject also has the roles
+// of its token.
+type Policy struct {
+	Roles    map[string][]Rule   `json:"roles"`
+	Subjects map[string][]string `json:"subjects,omitempty"`
+	logger   *log.Logger
+}
+
+// LoadPolicy reads a policy from a json file.
+func LoadPolicy(file string, logger *log.Logger) (*Policy, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read policy error: %v", err)
+	}
+	var p Policy
+	if err = json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("policy format error: %v", err)
+	}
+	return NewPolicy(p.Roles, p.Subjects, logger)
+}
+
+func NewPolicy(roles map[string][]Rule, subjects map[string][]string, logger *log.Logger) (*Policy, error) {
+	if logger == nil {
+		return nil, errors.New("nil logger")
+	}
+	for role, rules := range roles {
+		for _, rule := range rules {
+			for _, op := range rule.Operations {
+				if !slices.Contains(operations, op) {
+					return nil, fmt.Errorf("unknown operation %q of role %v", op, role)
+				}
+			}
+			for _, pattern := range rule.Kinds {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, fmt.Errorf("kind pattern %q of role %v error: %v", pattern, role, err)
+				}
+			}
+			for _, pattern := range rule.Namespaces {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, fmt.Errorf("namespace pattern %q of role %v error: %v", pattern, role, err)
+				}
+			}
+		}
+	}
+	for subject, names := range subjects {
+		for _, role := range names {
+			if _, ok := roles[role]; !ok {
+				return nil, fmt.Errorf("unknown role %v of subject %v", role, subject)
+			}
+		}
+	}
+	return &Policy{Roles: roles, Subjects: subjects, logger: logger}, nil
+}
+
+// Allow checks that the caller of ctx may do op on every kind in the namespace
+// of ctx, an empty kind is every kind and needs a rule of every kind, without
+// kinds op is enough.
+// A nil policy and a cluster node are allowed everything, a call without
+// caller nothing.
+func (p *Policy) Allow(ctx context.Context, op Operation, kinds ...string) error {
+	if p == nil {
+		return nil
+	}
+	caller, ok := FromContext(ctx)
+	if !ok {
+		return p.deny(Principal{Subject: "anonymous"}, op, contract.NamespaceFrom(ctx), kinds)
+	}
+	if caller.Cluster {
+		return nil
+	}
+
+	roles := append(slices.Clone(p.Subjects[caller.Subject]), caller.Roles...)
+	ns := contract.NamespaceFrom(ctx)
+	for _, kind := range kinds {
+		if !p.allowed(roles, op, func(r Rule) bool { return r.inNamespace(ns) && r.matches(kind) }) {
+			return p.deny(caller, op, ns, kinds)
+		}
+	}
+	if len(kinds) == 0 && !p.allowed(roles, op, func(r Rule) bool { return r.inNamespace(ns) }) {
+		return p.deny(caller, op, ns, kinds)
+	}
+	return nil
+}
+
+// AllowTasks checks op on the kinds of task ids t-{kind}-{tsid}.
+func (p *Policy) AllowTasks(ctx context.Context, op Operation, ids ...string) error {
+	kinds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		kinds = append(kinds, taskKind(id))
+	}
+	return p.Allow(ctx, op, kinds...)
+}
+
+// Kind is the kind of an optional kind filter, every kind when unset.
+func Kind(kind *string) string {
+	if kind == nil {
+		return ""
+	}
+	return *kind
+}
+
+// an id that is not a task id has no kind and needs a rule of every kind
+func taskKind(id string) string {
+	rest, ok := strings.CutPrefix(id, "t-")
+	if i := strings.LastIndex(rest, "-"); ok && i > 0 {
+		return rest[:i]
+	}
+	return ""
+}
+
+func (p *Policy) allowed(roles []string, op Operation, match func(r Rule) bool) bool {
+	for _, role := range roles {
+		for _, rule := range p.Roles[role] {
+			if (slices.Contains(rule.Operations, op) || slices.Contains(rule.Operations, ANY)) && match(rule) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r Rule) matches(kind string) bool {
+	if len(r.Kinds) == 0 {
+		return true
+	}
+	for _, pattern := range r.Kinds {
+		if pattern == "*" {
+			return true
+		}
+		// an empty kind is every kind
+		if ok, _ := path.Match(pattern, kind); ok && kind != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) inNamespace(ns string) bool {
+	if len(r.Namespaces) == 0 {
+		return true
+	}
+	for _, pattern := range r.Namespaces {
+		if ok, _ := path.Match(pattern, ns); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) deny(caller Principal, op Operation, ns string, kinds []string) error {
+	p.logger.Printf("Access denied: %v roles %v operation %v namespace %q kinds %v", caller.Subject, caller.Roles, op, ns, kinds)
+	return fmt.Errorf("%w: %v of %v", ErrForbidden, caller.Subject, op)
+}
@@ -0,0 +1,108 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestPolicy_Allow(t *testing.T) {
+	var logs strings.Builder
+	p, err := NewPolicy(map[string][]Rule{
+		"worker": {{Operations: []Operation{POOL, UPDATE, OWNER_REG, OWNER_HEARTBEAT}, Kinds: []string{"mail*"}}},
+		"ops":    {{Operations: []Operation{ANY}}},
+		"team":   {{Operations: []Operation{ADD}, Namespaces: []string{"team*"}}},
+	}, map[string][]string{"mailer": {"worker"}}, log.New(&logs, "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	as := func(caller Principal) context.Context {
+		return WithPrincipal(context.Background(), caller)
+	}
+	mailer := as(Principal{Subject: "mailer"})
+
+	if err = p.Allow(mailer, POOL, "mail"); err != nil {
+		t.Errorf("not correct pool of own kind %v", err)
+	}
+	if err = p.AllowTasks(mailer, UPDATE, "t-mail-bulk-06KC32YC00001"); err != nil {
+		t.Errorf("not correct update of own task %v", err)
+	}
+	if err = p.Allow(mailer, OWNER_HEARTBEAT); err != nil {
+		t.Errorf("not correct operation without kind %v", err)
+	}
+	for name, err := range map[string]error{
+		"other kind":      p.Allow(mailer, POOL, "sms"),
+		"one other kind":  p.Allow(mailer, OWNER_REG, "mail", "sms"),
+		"every kind":      p.Allow(mailer, OWNER_UNREG, ""),
+		"search delete":   p.Allow(mailer, SEARCH_DELETE, "mail"),
+		"other task":      p.AllowTasks(mailer, UPDATE, "t-sms-06KC32YC00001"),
+		"unknown subject": p.Allow(as(Principal{Subject: "x"}), POOL, "mail"),
+	} {
+		if !errors.Is(err, ErrForbidden) {
+			t.Errorf("not correct %v allowed %v", name, err)
+		}
+	}
+	if !strings.Contains(logs.String(), "mailer") {
+		t.Errorf("not correct log of denied access %q", logs.String())
+	}
+
+	team := as(Principal{Subject: "bob", Roles: []string{"team"}})
+	if err = p.Allow(contract.WithNamespace(team, "team1"), ADD, "mail"); err != nil {
+		t.Errorf("not correct add in own namespace %v", err)
+	}
+	for _, ns := range []string{"", "other"} {
+		if err = p.Allow(contract.WithNamespace(team, ns), ADD, "mail"); !errors.Is(err, ErrForbidden) {
+			t.Errorf("not correct add in namespace %q allowed %v", ns, err)
+		}
+	}
+
+	ops := as(Principal{Subject: "alice", Roles: []string{"ops"}})
+	if err = p.Allow(ops, SEARCH_DELETE, ""); err != nil {
+		t.Errorf("not correct search delete by ops %v", err)
+	}
+	if err = p.Allow(context.Background(), GET, "mail"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("not correct call without caller allowed %v", err)
+	}
+	for name, ctx := range map[string]context.Context{
+		"cluster":  as(Principal{Subject: "cluster", Cluster: true}),
+		"internal": Internal(mailer),
+	} {
+		if err = p.Allow(ctx, SEARCH_DELETE, ""); err != nil {
+			t.Errorf("not correct call %v denied %v", name, err)
+		}
+	}
+	if err = (*Policy)(nil).Allow(mailer, SEARCH_DELETE, ""); err != nil {
+		t.Errorf("not correct nil policy %v", err)
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policy.json")
+	logger := log.New(os.Stderr, "", 0)
+
+	os.WriteFile(file, []byte(`{"roles":{"ops":[{"operations":["*"],"kinds":["*"]}]},"subjects":{"ci":["ops"]}}`), 0o600)
+	p, err := LoadPolicy(file, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Allow(WithPrincipal(context.Background(), Principal{Subject: "ci"}), OWNER_UNREG, ""); err != nil {
+		t.Errorf("not correct loaded policy %v", err)
+	}
+
+	for name, policy := range map[string]string{
+		"unknown operation": `{"roles":{"ops":[{"operations":["drop"]}]}}`,
+		"bad pattern":       `{"roles":{"ops":[{"operations":["*"],"kinds":["["]}]}}`,
+		"unknown role":      `{"roles":{},"subjects":{"ci":["ops"]}}`,
+	} {
+		os.WriteFile(file, []byte(policy), 0o600)
+		if _, err = LoadPolicy(file, logger); err == nil {
+			t.Errorf("not correct %v accepted", name)
+		}
+	}
+}
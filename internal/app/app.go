@@ -13,13 +13,24 @@ type Application struct {
 type Commands struct {
 	AddTask               command.AddTaskHandler
 	UpdateTask            command.UpdateTaskHandler
+	AddTaskBatch          command.AddTaskBatchHandler
+	UpdateTaskBatch       command.UpdateTaskBatchHandler
 	OwnerReg              command.OwnerRegHandler
 	OwnerUnReg            command.OwnerUnRegHandler
+	OwnerHeartbeat        command.OwnerHeartbeatHandler
+	ExpireOwners          command.ExpireOwnersHandler
 	SearchDeleteTask      command.SearchDeleteTaskHandler
 	SearchDeleteErrorTask command.SearchDeleteErrorTaskHandler
 	SearchUpdateTask      command.SearchUpdateTaskHandler
 	SearchUpdateErrorTask command.SearchUpdateErrorTaskHandler
 	HealthCheck           command.HealthCheckHandler
+	WebhookReg            command.WebhookRegHandler
+	WebhookUnReg          command.WebhookUnRegHandler
+	DeliverWebhooks       command.DeliverWebhooksHandler
+	Pause                 command.PauseHandler
+	KindConfig            command.KindConfigHandler
+	NamespaceCreate       command.NamespaceCreateHandler
+	NamespaceDrop         command.NamespaceDropHandler
 }
 
 type Queries struct {
@@ -28,4 +39,13 @@ type Queries struct {
 	Get             query.GetHandler
 	SearchTask      query.SearchTaskHandler
 	SearchError     query.SearchErrorTaskHandler
+	Events          query.EventsHandler
+	Webhooks        query.WebhooksHandler
+	Owners          query.OwnersHandler
+	KindOwners      query.KindOwnersHandler
+	Status          query.StatusHandler
+	Paused          query.PausedHandler
+	KindConfigs     query.KindConfigsHandler
+	InFlight        query.InFlightHandler
+	Namespaces      query.NamespacesHandler
 }
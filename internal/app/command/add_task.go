@@ -1,11 +1,12 @@
 package command
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
@@ -17,11 +18,15 @@ type AddTaskDbAdapter interface {
 		param map[string]string,
 	) (events []contract.Event, err error)
 
+	// TaskId is the id of the task added by the events of a key.
+	TaskId(key []byte) (id string)
+
 	Apply(events []contract.Event) (err error)
 }
 
 type AddTaskClusterAdapter interface {
 	Add(
+		ctx context.Context,
 		url string,
 		group string,
 		kind string,
@@ -35,7 +40,8 @@ type AddTaskHandler struct {
 	cluster AddTaskClusterAdapter
 	ring    *hashring.HashRing
 	curUrl  string
-	raft    *raft.Raft
+	raft    *Committer
+	policy  *access.Policy
 }
 
 func NewAddTaskHandler(
@@ -43,7 +49,8 @@ func NewAddTaskHandler(
 	cluster AddTaskClusterAdapter,
 	ring *hashring.HashRing,
 	url string,
-	raft *raft.Raft,
+	raft *Committer,
+	policy *access.Policy,
 ) (h AddTaskHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil AddTaskDbAdapter")
@@ -64,10 +71,12 @@ func NewAddTaskHandler(
 		ring:    ring,
 		curUrl:  url,
 		raft:    raft,
+		policy:  policy,
 	}, nil
 }
 
 func (h AddTaskHandler) Handle(
+	ctx context.Context,
 	group string,
 	kind string,
 	owner *string,
@@ -79,6 +88,9 @@ func (h AddTaskHandler) Handle(
 	if kind == "" {
 		return id, errors.New("kind is empty")
 	}
+	if err = h.policy.Allow(ctx, access.ADD, kind); err != nil {
+		return id, err
+	}
 
 	node, exists := h.ring.GetNode(group)
 	if !exists {
@@ -94,9 +106,9 @@ func (h AddTaskHandler) Handle(
 		if err != nil {
 			return id, err
 		}
-		id = string(events[0].Key)
+		id = h.db.TaskId(events[0].Key)
 		return id, nil
 	} else {
-		return h.cluster.Add(node, group, kind, owner, param)
+		return h.cluster.Add(ctx, node, group, kind, owner, param)
 	}
 }
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/serialx/hashring"
+)
+
+type AddTaskBatchClusterAdapter interface {
+	AddBatch(
+		ctx context.Context,
+		url string,
+		tasks []contract.AddRequest,
+	) (items []contract.BatchItem, err error)
+}
+
+type AddTaskBatchHandler struct {
+	db      AddTaskDbAdapter
+	cluster AddTaskBatchClusterAdapter
+	ring    *hashring.HashRing
+	curUrl  string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewAddTaskBatchHandler(
+	db AddTaskDbAdapter,
+	cluster AddTaskBatchClusterAdapter,
+	ring *hashring.HashRing,
+	url string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h AddTaskBatchHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil AddTaskDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil AddTaskBatchClusterAdapter")
+	}
+	if ring == nil {
+		return h, errors.New("nil ring")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+
+	return AddTaskBatchHandler{
+		db:      db,
+		cluster: cluster,
+		ring:    ring,
+		curUrl:  url,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+func (h AddTaskBatchHandler) Handle(
+	ctx context.Context,
+	tasks []contract.AddRequest,
+) (items []contract.BatchItem, err error) {
+	kinds := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		kinds = append(kinds, t.Kind)
+	}
+	if err = h.policy.Allow(ctx, access.ADD, kinds...); err != nil {
+		return nil, err
+	}
+	return runBatch(ctx, h.fanout, h.ring, h.curUrl, tasks,
+		func(t contract.AddRequest) string { return t.Group },
+		h.addLocal,
+		h.cluster.AddBatch,
+	)
+}
+
+// addLocal commits the tasks of the current node in a single raft entry.
+func (h AddTaskBatchHandler) addLocal(tasks []contract.AddRequest) (items []contract.BatchItem) {
+	items = make([]contract.BatchItem, len(tasks))
+	var events []contract.Event
+	var added []int
+	for i, t := range tasks {
+		if t.Kind == "" {
+			batchError(items, []int{i}, errors.New("kind is empty"))
+			continue
+		}
+		e, err := h.db.Add(t.Group, t.Kind, t.Owner, t.Param)
+		if err != nil {
+			batchError(items, []int{i}, err)
+			continue
+		}
+		items[i].Id = h.db.TaskId(e[0].Key)
+		events = append(events, e...)
+		added = append(added, i)
+	}
+
+	if len(events) > 0 {
+		if err := raftApply(h.raft, h.db, events); err != nil {
+			batchError(items, added, err)
+		}
+	}
+	return items
+}
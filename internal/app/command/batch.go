@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/serialx/hashring"
+)
+
+const (
+	maxBatchSize = 10000
+)
+
+// runBatch splits items by ring node, the items of the current node are
+// handled by local and every other node gets a single forwarded request.
+// A failed node fails all of its items, results keep the order of items.
+func runBatch[T any](
+	ctx context.Context,
+	fan fanout.Executor,
+	ring *hashring.HashRing,
+	curUrl string,
+	items []T,
+	group func(T) string,
+	local func(items []T) []contract.BatchItem,
+	remote func(ctx context.Context, node string, items []T) ([]contract.BatchItem, error),
+) (results []contract.BatchItem, err error) {
+	if len(items) == 0 {
+		return nil, errors.New("batch is empty")
+	}
+	if len(items) > maxBatchSize {
+		return nil, fmt.Errorf("batch is too large, max size %d", maxBatchSize)
+	}
+
+	results = make([]contract.BatchItem, len(items))
+	byNode := make(map[string][]int)
+	var nodes []string
+	for i, item := range items {
+		g := group(item)
+		if g == "" {
+			batchError(results, []int{i}, errors.New("group is empty"))
+			continue
+		}
+		node, exists := ring.GetNode(g)
+		if !exists {
+			batchError(results, []int{i}, fmt.Errorf("not found node by group: %v", g))
+			continue
+		}
+		if _, ok := byNode[node]; !ok {
+			nodes = append(nodes, node)
+		}
+		byNode[node] = append(byNode[node], i)
+	}
+
+	nodeResults := fanout.Run(ctx, fan, nodes,
+		func(ctx context.Context, node string) ([]contract.BatchItem, error) {
+			nodeItems := make([]T, 0, len(byNode[node]))
+			for _, i := range byNode[node] {
+				nodeItems = append(nodeItems, items[i])
+			}
+			var res []contract.BatchItem
+			var err error
+			if node == curUrl {
+				res = local(nodeItems)
+			} else {
+				res, err = remote(ctx, node, nodeItems)
+			}
+			if err == nil && len(res) != len(nodeItems) {
+				err = fmt.Errorf("node %v returned %d results for %d items", node, len(res), len(nodeItems))
+			}
+			return res, err
+		},
+	)
+	for _, r := range nodeResults {
+		if r.Err != nil {
+			batchError(results, byNode[r.Node], r.Err)
+			continue
+		}
+		for j, i := range byNode[r.Node] {
+			results[i] = r.Value[j]
+		}
+	}
+
+	return results, nil
+}
+
+func batchError(results []contract.BatchItem, idx []int, err error) {
+	msg := err.Error()
+	for _, i := range idx {
+		results[i] = contract.BatchItem{Error: &msg}
+	}
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/serialx/hashring"
+)
+
+func TestRunBatch(t *testing.T) {
+	ring := hashring.New([]string{"a", "b"})
+	groups := []string{"g1", "g2", "g3", "g4", "", "g5"}
+
+	calls := map[string]int{}
+	results, err := runBatch(context.Background(), fanout.NewExecutor(time.Second), ring, "a", groups,
+		func(g string) string { return g },
+		func(items []string) (res []contract.BatchItem) {
+			calls["a"]++
+			for _, g := range items {
+				res = append(res, contract.BatchItem{Id: g})
+			}
+			return res
+		},
+		func(ctx context.Context, node string, items []string) ([]contract.BatchItem, error) {
+			calls[node]++
+			return nil, errors.New("down")
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != len(groups) {
+		t.Fatalf("not correct count results %d", len(results))
+	}
+	for i, g := range groups {
+		node, _ := ring.GetNode(g)
+		r := results[i]
+		switch {
+		case g == "":
+			if r.Error == nil {
+				t.Errorf("not correct result for empty group")
+			}
+		case node == "a":
+			if r.Id != g || r.Error != nil {
+				t.Errorf("not correct local result %d: %+v", i, r)
+			}
+		default:
+			if r.Error == nil || *r.Error != "down" {
+				t.Errorf("not correct failed node result %d: %+v", i, r)
+			}
+		}
+	}
+	for node, n := range calls {
+		if n != 1 {
+			t.Errorf("node %v called %d times", node, n)
+		}
+	}
+
+	if _, err = runBatch(context.Background(), fanout.NewExecutor(time.Second), ring, "a", []string{},
+		func(g string) string { return g }, nil, nil); err == nil {
+		t.Errorf("not correct empty batch")
+	}
+}
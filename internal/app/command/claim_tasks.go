@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type ClaimTasksDbAdapter interface {
+	Claim(owner string, kind string, size uint, steal bool) (events []contract.Event, tasks []contract.Task, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type ClaimTasksHandler struct {
+	db   ClaimTasksDbAdapter
+	raft *Committer
+	// a claim is read and applied under the lock, two owners never get the same task
+	mu *sync.Mutex
+}
+
+func NewClaimTasksHandler(db ClaimTasksDbAdapter, raft *Committer) (h ClaimTasksHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil ClaimTasksDbAdapter")
+	}
+
+	return ClaimTasksHandler{db: db, raft: raft, mu: &sync.Mutex{}}, nil
+}
+
+// Handle gives owner tasks of kind of the node that have no owner, with steal
+// also VIRGIN tasks of the busiest owner. The tasks of a node are written by
+// its raft group only, so a node lock makes the claim atomic.
+func (h ClaimTasksHandler) Handle(
+	ctx context.Context,
+	owner string,
+	kind string,
+	size uint,
+	steal bool,
+) (tasks []contract.Task, err error) {
+	if owner == "" {
+		return nil, errors.New("owner is empty")
+	}
+	if kind == "" {
+		return nil, errors.New("kind is empty")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	events, tasks, err := h.db.Claim(owner, kind, size, steal)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	if err = raftApply(h.raft, h.db, events); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
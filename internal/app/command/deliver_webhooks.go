@@ -0,0 +1,121 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	webhookBatch       = 100
+	webhookMaxAttempts = 10
+	webhookMinBackoff  = time.Second
+	webhookMaxBackoff  = 10 * time.Minute
+)
+
+type DeliverWebhooksDbAdapter interface {
+	Outbox(ctx context.Context, now time.Time, size int) (deliveries []contract.WebhookDelivery, err error)
+	Webhook(id string) (webhook *contract.Webhook, err error)
+	WebhookDelivered(id string) (events []contract.Event)
+	WebhookRetry(delivery contract.WebhookDelivery) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, url string, secret string, event contract.WebhookEvent) (err error)
+}
+
+type DeliverWebhooksHandler struct {
+	db     DeliverWebhooksDbAdapter
+	sender WebhookSender
+	raft   *Committer
+}
+
+func NewDeliverWebhooksHandler(
+	db DeliverWebhooksDbAdapter,
+	sender WebhookSender,
+	raft *Committer,
+) (h DeliverWebhooksHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil DeliverWebhooksDbAdapter")
+	}
+	if sender == nil {
+		return h, errors.New("nil WebhookSender")
+	}
+
+	return DeliverWebhooksHandler{db: db, sender: sender, raft: raft}, nil
+}
+
+// Handle sends due deliveries of the outbox, only the raft leader delivers so
+// every event is sent by exactly one node of its group. Delivery is at least
+// once, receivers deduplicate by the event id.
+func (h DeliverWebhooksHandler) Handle(ctx context.Context) (err error) {
+	if h.raft != nil && !h.raft.Leader() {
+		return nil
+	}
+
+	deliveries, err := h.db.Outbox(ctx, time.Now(), webhookBatch)
+	if err != nil {
+		return err
+	}
+
+	var events []contract.Event
+	var errs []error
+	for _, d := range deliveries {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		webhook, err := h.db.Webhook(d.Webhook)
+		if err != nil {
+			return err
+		}
+		if webhook == nil {
+			// unsubscribed while pending
+			events = append(events, h.db.WebhookDelivered(d.Id)...)
+			continue
+		}
+
+		err = h.sender.Send(ctx, webhook.Url, webhook.Secret, contract.WebhookEvent{
+			Id:      d.Id,
+			Webhook: d.Webhook,
+			Status:  d.Status,
+			Task:    d.Task,
+		})
+		if err == nil {
+			events = append(events, h.db.WebhookDelivered(d.Id)...)
+			continue
+		}
+
+		d.Attempt++
+		if d.Attempt >= webhookMaxAttempts {
+			errs = append(errs, fmt.Errorf("webhook %v delivery %v dropped after %d attempts: %v", d.Webhook, d.Id, d.Attempt, err))
+			events = append(events, h.db.WebhookDelivered(d.Id)...)
+			continue
+		}
+		errs = append(errs, fmt.Errorf("webhook %v delivery %v attempt %d error: %v", d.Webhook, d.Id, d.Attempt, err))
+		d.Next = time.Now().Add(webhookBackoff(d.Attempt))
+		retry, err := h.db.WebhookRetry(d)
+		if err != nil {
+			return err
+		}
+		events = append(events, retry...)
+	}
+
+	if len(events) > 0 {
+		if err = raftApply(h.raft, h.db, events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func webhookBackoff(attempt int) time.Duration {
+	backoff := webhookMinBackoff << (attempt - 1)
+	if backoff <= 0 || backoff > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type ExpireOwnersDbAdapter interface {
+	ExpiredOwners(now time.Time) (owners []string, err error)
+	OwnerExpire(owner string, now time.Time) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type ExpireOwnersHandler struct {
+	db   ExpireOwnersDbAdapter
+	raft *Committer
+}
+
+func NewExpireOwnersHandler(db ExpireOwnersDbAdapter, raft *Committer) (h ExpireOwnersHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil ExpireOwnersDbAdapter")
+	}
+
+	return ExpireOwnersHandler{db: db, raft: raft}, nil
+}
+
+// Handle unregisters the owners that missed their heartbeat and hands their
+// tasks over to the live owners, only the raft leader expires owners.
+func (h ExpireOwnersHandler) Handle(ctx context.Context) (err error) {
+	if h.raft != nil && !h.raft.Leader() {
+		return nil
+	}
+
+	now := time.Now()
+	owners, err := h.db.ExpiredOwners(now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, owner := range owners {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		events, err := h.db.OwnerExpire(owner, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("owner %v expire error: %v", owner, err))
+			continue
+		}
+		if err = raftApply(h.raft, h.db, events); err != nil {
+			errs = append(errs, fmt.Errorf("owner %v expire error: %v", owner, err))
+		}
+	}
+	return errors.Join(errs...)
+}
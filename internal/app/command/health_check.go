@@ -1,10 +1,10 @@
 package command
 
 import (
+	"context"
 	"errors"
 
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 )
 
 type HealthCheckDbAdapter interface {
@@ -14,12 +14,12 @@ type HealthCheckDbAdapter interface {
 
 type HealthCheckHandler struct {
 	db   HealthCheckDbAdapter
-	raft *raft.Raft
+	raft *Committer
 }
 
 func NewHealthCheckHandler(
 	db HealthCheckDbAdapter,
-	raft *raft.Raft,
+	raft *Committer,
 ) (h HealthCheckHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil HealthCheckDbAdapter")
@@ -28,7 +28,7 @@ func NewHealthCheckHandler(
 	return HealthCheckHandler{db: db, raft: raft}, nil
 }
 
-func (h HealthCheckHandler) Handle() (err error) {
+func (h HealthCheckHandler) Handle(ctx context.Context) (err error) {
 	events, err := h.db.HealthCheck()
 	if err != nil {
 		return err
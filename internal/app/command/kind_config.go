@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type KindConfigDbAdapter interface {
+	KindConfigSet(config contract.KindConfig) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type KindConfigClusterAdapter interface {
+	KindConfigSet(ctx context.Context, url string, config contract.KindConfig) (err error)
+}
+
+type KindConfigHandler struct {
+	db      KindConfigDbAdapter
+	cluster KindConfigClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewKindConfigHandler(
+	db KindConfigDbAdapter,
+	cluster KindConfigClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h KindConfigHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil KindConfigDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil KindConfigClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return KindConfigHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle stores the config of a kind on every node, the tasks of a kind are on all of them.
+func (h KindConfigHandler) Handle(
+	ctx context.Context,
+	config contract.KindConfig,
+	internal bool,
+) (err error) {
+	if config.Kind == "" {
+		return errors.New("kind is empty")
+	}
+	if !config.Strategy.Valid() {
+		return fmt.Errorf("unknown strategy %q", config.Strategy)
+	}
+	if (config.Strategy == contract.HASH) != (config.HashParam != "") {
+		return errors.New("hash param is required by hash strategy only")
+	}
+	if err = h.policy.Allow(ctx, access.KIND_CONFIG, config.Kind); err != nil {
+		return err
+	}
+
+	if internal {
+		return h.local(config)
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.local(config)
+			}
+			return struct{}{}, h.cluster.KindConfigSet(ctx, node, config)
+		},
+	)
+	return fanout.FirstError(results)
+}
+
+func (h KindConfigHandler) local(config contract.KindConfig) error {
+	events, err := h.db.KindConfigSet(config)
+	if err != nil {
+		return err
+	}
+	return raftApply(h.raft, h.db, events)
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type NamespaceCreateDbAdapter interface {
+	NamespaceCreate(ns contract.Namespace) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type NamespaceCreateClusterAdapter interface {
+	NamespaceCreate(ctx context.Context, url string, ns contract.Namespace) (err error)
+}
+
+type NamespaceCreateHandler struct {
+	db      NamespaceCreateDbAdapter
+	cluster NamespaceCreateClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewNamespaceCreateHandler(
+	db NamespaceCreateDbAdapter,
+	cluster NamespaceCreateClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h NamespaceCreateHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil NamespaceCreateDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil NamespaceCreateClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return NamespaceCreateHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle creates a namespace on every node or changes its quotas.
+func (h NamespaceCreateHandler) Handle(
+	ctx context.Context,
+	ns contract.Namespace,
+	internal bool,
+) (err error) {
+	if !contract.ValidNamespace(ns.Name) {
+		return fmt.Errorf("invalid namespace %q", ns.Name)
+	}
+	if ns.MaxTasks < 0 || ns.MaxBytes < 0 {
+		return errors.New("quota is negative")
+	}
+	if err = h.policy.Allow(ctx, access.NAMESPACE); err != nil {
+		return err
+	}
+
+	if internal {
+		return h.local(ns)
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.local(ns)
+			}
+			return struct{}{}, h.cluster.NamespaceCreate(ctx, node, ns)
+		},
+	)
+	return fanout.FirstError(results)
+}
+
+func (h NamespaceCreateHandler) local(ns contract.Namespace) error {
+	events, err := h.db.NamespaceCreate(ns)
+	if err != nil {
+		return err
+	}
+	return raftApply(h.raft, h.db, events)
+}
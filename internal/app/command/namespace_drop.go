@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+// keys of a dropped namespace removed by one raft log entry
+const namespacePurgeSize = DefaultCommitSize
+
+type NamespaceDropDbAdapter interface {
+	NamespaceDrop(name string) (events []contract.Event, found bool, err error)
+	NamespacePurge(name string, size int) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type NamespaceDropClusterAdapter interface {
+	NamespaceDrop(ctx context.Context, url string, name string) (err error)
+}
+
+type NamespaceDropHandler struct {
+	db      NamespaceDropDbAdapter
+	cluster NamespaceDropClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewNamespaceDropHandler(
+	db NamespaceDropDbAdapter,
+	cluster NamespaceDropClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h NamespaceDropHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil NamespaceDropDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil NamespaceDropClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return NamespaceDropHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle drops a namespace with all its keys on every node. A drop that was
+// interrupted removes the keys left when it is called again.
+func (h NamespaceDropHandler) Handle(ctx context.Context, name string, internal bool) (err error) {
+	if !contract.ValidNamespace(name) {
+		return fmt.Errorf("invalid namespace %q", name)
+	}
+	if err = h.policy.Allow(ctx, access.NAMESPACE); err != nil {
+		return err
+	}
+
+	if internal {
+		_, err = h.local(ctx, name)
+		return err
+	}
+
+	found := false
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				ok, err := h.local(ctx, name)
+				found = ok
+				return struct{}{}, err
+			}
+			return struct{}{}, h.cluster.NamespaceDrop(ctx, node, name)
+		},
+	)
+	if err = fanout.FirstError(results); err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%w: %v", contract.ErrNamespaceNotFound, name)
+	}
+	return nil
+}
+
+func (h NamespaceDropHandler) local(ctx context.Context, name string) (found bool, err error) {
+	events, found, err := h.db.NamespaceDrop(name)
+	if err != nil || !found {
+		return found, err
+	}
+	if len(events) > 0 {
+		if err = raftApply(h.raft, h.db, events); err != nil {
+			return found, err
+		}
+	}
+	for {
+		if err = ctx.Err(); err != nil {
+			return found, err
+		}
+		events, err = h.db.NamespacePurge(name, namespacePurgeSize)
+		if err != nil || len(events) == 0 {
+			return found, err
+		}
+		if err = raftApply(h.raft, h.db, events); err != nil {
+			return found, err
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const minOwnerTtl = time.Second
+
+type OwnerHeartbeatDbAdapter interface {
+	OwnerHeartbeat(owner string, expires time.Time) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type OwnerHeartbeatClusterAdapter interface {
+	OwnerHeartbeat(ctx context.Context, url string, owner string, ttl time.Duration) (err error)
+}
+
+type OwnerHeartbeatHandler struct {
+	db      OwnerHeartbeatDbAdapter
+	cluster OwnerHeartbeatClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewOwnerHeartbeatHandler(
+	db OwnerHeartbeatDbAdapter,
+	cluster OwnerHeartbeatClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h OwnerHeartbeatHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil ownerHeartbeatDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil ownerHeartbeatClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return OwnerHeartbeatHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle keeps the owner alive for ttl on every node.
+func (h OwnerHeartbeatHandler) Handle(
+	ctx context.Context,
+	owner string,
+	ttl time.Duration,
+	internal bool,
+) (err error) {
+	if owner == "" {
+		return errors.New("owner is empty")
+	}
+	if ttl < minOwnerTtl {
+		return fmt.Errorf("ttl must be at least %v", minOwnerTtl)
+	}
+	if err = h.policy.Allow(ctx, access.OWNER_HEARTBEAT); err != nil {
+		return err
+	}
+
+	if internal {
+		return h.beat(owner, ttl)
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.beat(owner, ttl)
+			}
+			return struct{}{}, h.cluster.OwnerHeartbeat(ctx, node, owner, ttl)
+		},
+	)
+	return fanout.FirstError(results)
+}
+
+func (h OwnerHeartbeatHandler) beat(owner string, ttl time.Duration) (err error) {
+	events, err := h.db.OwnerHeartbeat(owner, time.Now().Add(ttl))
+	if err != nil {
+		return err
+	}
+	return raftApply(h.raft, h.db, events)
+}
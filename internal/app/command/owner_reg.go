@@ -1,20 +1,25 @@
 package command
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
 type OwnerRegDbAdapter interface {
-	OwnerReg(owner string, kinds []string) (events []contract.Event)
+	OwnerReg(owner string, kinds []string, weight int) (events []contract.Event)
+	OwnerHeartbeat(owner string, expires time.Time) (events []contract.Event, err error)
 	Apply(events []contract.Event) (err error)
 }
 
 type OwnerRegClusterAdapter interface {
-	OwnerReg(url string, owner string, kinds []string) (err error)
+	OwnerReg(ctx context.Context, url string, owner string, kinds []string, ttl time.Duration, weight int) (err error)
 }
 
 type OwnerRegHandler struct {
@@ -23,7 +28,9 @@ type OwnerRegHandler struct {
 	ring    *hashring.HashRing
 	curUrl  string
 	nodes   []string
-	raft    *raft.Raft
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
 }
 
 func NewOwnerRegHandler(
@@ -32,7 +39,9 @@ func NewOwnerRegHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
-	raft *raft.Raft,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
 ) (h OwnerRegHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil ownerRegDbAdapter")
@@ -57,12 +66,17 @@ func NewOwnerRegHandler(
 		curUrl:  url,
 		nodes:   nodes,
 		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
 	}, nil
 }
 
 func (h OwnerRegHandler) Handle(
+	ctx context.Context,
 	owner string,
 	kinds []string,
+	ttl time.Duration,
+	weight int,
 	internal bool,
 ) (err error) {
 	if owner == "" {
@@ -71,23 +85,41 @@ func (h OwnerRegHandler) Handle(
 	if len(kinds) == 0 {
 		return errors.New("kinds is empty")
 	}
+	if ttl != 0 && ttl < minOwnerTtl {
+		return fmt.Errorf("ttl must be at least %v", minOwnerTtl)
+	}
+	if err = h.policy.Allow(ctx, access.OWNER_REG, kinds...); err != nil {
+		return err
+	}
+	if weight < 0 {
+		return errors.New("weight is negative")
+	}
 
 	if internal {
-		events := h.db.OwnerReg(owner, kinds)
-		return raftApply(h.raft, h.db, events)
-
+		return h.reg(owner, kinds, ttl, weight)
 	}
 
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			events := h.db.OwnerReg(owner, kinds)
-			err = raftApply(h.raft, h.db, events)
-		} else {
-			err = h.cluster.OwnerReg(node, owner, kinds)
-		}
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.reg(owner, kinds, ttl, weight)
+			}
+			return struct{}{}, h.cluster.OwnerReg(ctx, node, owner, kinds, ttl, weight)
+		},
+	)
+	return fanout.FirstError(results)
+}
+
+// reg keeps the beat of an owner registered without a ttl, the ttl of its
+// other kinds still applies.
+func (h OwnerRegHandler) reg(owner string, kinds []string, ttl time.Duration, weight int) (err error) {
+	events := h.db.OwnerReg(owner, kinds, weight)
+	if ttl > 0 {
+		beat, err := h.db.OwnerHeartbeat(owner, time.Now().Add(ttl))
 		if err != nil {
 			return err
 		}
+		events = append(events, beat...)
 	}
-	return err
+	return raftApply(h.raft, h.db, events)
 }
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/adapters/store/leveldb"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/serialx/hashring"
+	level "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+type nodeCluster struct{}
+
+func (nodeCluster) OwnerReg(ctx context.Context, url string, owner string, kinds []string, ttl time.Duration, weight int) error {
+	return nil
+}
+
+func TestOwnerRegHandler_KeepsTtl(t *testing.T) {
+	db, err := level.Open(storage.NewMemStorage(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	adapter, err := leveldb.NewLevelAdapter(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes := []string{"a"}
+	h, err := NewOwnerRegHandler(adapter, nodeCluster{}, hashring.New(nodes), "a", nodes, nil, fanout.NewExecutor(time.Second), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err = h.Handle(ctx, "100", []string{"A"}, minOwnerTtl, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if err = h.Handle(ctx, "100", []string{"B"}, 0, 0, false); err != nil {
+		t.Fatal(err)
+	}
+
+	expired, err := adapter.ExpiredOwners(time.Now().Add(2 * minOwnerTtl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(expired, []string{"100"}) {
+		t.Errorf("not correct ttl after registration without ttl %v", expired)
+	}
+}
@@ -1,20 +1,22 @@
 package command
 
 import (
+	"context"
 	"errors"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
 type OwnerUnRegDbAdapter interface {
-	OwnerUnReg(owner string) (events []contract.Event, err error)
+	OwnerUnReg(owner string, kind string, handoff bool) (events []contract.Event, err error)
 	Apply(events []contract.Event) (err error)
 }
 
 type OwnerUnRegClusterAdapter interface {
-	OwnerUnReg(url string, owner string) (err error)
+	OwnerUnReg(ctx context.Context, url string, owner string, kind string, handoff bool) (err error)
 }
 
 type OwnerUnRegHandler struct {
@@ -23,7 +25,9 @@ type OwnerUnRegHandler struct {
 	ring    *hashring.HashRing
 	curUrl  string
 	nodes   []string
-	raft    *raft.Raft
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
 }
 
 func NewOwnerUnRegHandler(
@@ -32,7 +36,9 @@ func NewOwnerUnRegHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
-	raft *raft.Raft,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
 ) (OwnerUnRegHandler, error) {
 	if db == nil {
 		return OwnerUnRegHandler{}, errors.New("nil OwnerUnRegDbAdapter")
@@ -57,35 +63,46 @@ func NewOwnerUnRegHandler(
 		curUrl:  url,
 		nodes:   nodes,
 		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
 	}, nil
 }
 
-func (h OwnerUnRegHandler) Handle(owner string, internal bool) (err error) {
+// Handle unregisters owner from kind, or from every kind when kind is empty,
+// with handoff its tasks go to the other owners of the kind.
+func (h OwnerUnRegHandler) Handle(
+	ctx context.Context,
+	owner string,
+	kind string,
+	handoff bool,
+	internal bool,
+) (err error) {
 	if owner == "" {
 		return errors.New("owner is empty")
 	}
+	if err = h.policy.Allow(ctx, access.OWNER_UNREG, kind); err != nil {
+		return err
+	}
 
 	if internal {
-		events, err := h.db.OwnerUnReg(owner)
+		events, err := h.db.OwnerUnReg(owner, kind, handoff)
 		if err != nil {
 			return err
 		}
 		return raftApply(h.raft, h.db, events)
 	}
 
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			events, err := h.db.OwnerUnReg(owner)
-			if err != nil {
-				return err
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				events, err := h.db.OwnerUnReg(owner, kind, handoff)
+				if err != nil {
+					return struct{}{}, err
+				}
+				return struct{}{}, raftApply(h.raft, h.db, events)
 			}
-			err = raftApply(h.raft, h.db, events)
-		} else {
-			err = h.cluster.OwnerUnReg(node, owner)
-		}
-		if err != nil {
-			return err
-		}
-	}
-	return err
+			return struct{}{}, h.cluster.OwnerUnReg(ctx, node, owner, kind, handoff)
+		},
+	)
+	return fanout.FirstError(results)
 }
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type PauseDbAdapter interface {
+	Pause(kind string, group string) (events []contract.Event, err error)
+	Resume(kind string, group string) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type PauseClusterAdapter interface {
+	Pause(ctx context.Context, url string, kind string, group string, paused bool) (err error)
+}
+
+type PauseHandler struct {
+	db      PauseDbAdapter
+	cluster PauseClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewPauseHandler(
+	db PauseDbAdapter,
+	cluster PauseClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h PauseHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil PauseDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil PauseClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return PauseHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle pauses or resumes a kind or a group on every node, a kind has tasks
+// on all of them and a paused group stays paused when the ring changes.
+func (h PauseHandler) Handle(
+	ctx context.Context,
+	kind string,
+	group string,
+	paused bool,
+	internal bool,
+) (err error) {
+	if (kind == "") == (group == "") {
+		return errors.New("kind or group is required")
+	}
+	// a group has tasks of every kind
+	if err = h.policy.Allow(ctx, access.PAUSE, kind); err != nil {
+		return err
+	}
+
+	if internal {
+		return h.local(kind, group, paused)
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.local(kind, group, paused)
+			}
+			return struct{}{}, h.cluster.Pause(ctx, node, kind, group, paused)
+		},
+	)
+	return fanout.FirstError(results)
+}
+
+func (h PauseHandler) local(kind string, group string, paused bool) error {
+	var events []contract.Event
+	var err error
+	if paused {
+		events, err = h.db.Pause(kind, group)
+	} else {
+		events, err = h.db.Resume(kind, group)
+	}
+	if err != nil {
+		return err
+	}
+	return raftApply(h.raft, h.db, events)
+}
@@ -10,22 +10,189 @@ import (
 
 const (
 	raftTimeout = 10 * time.Second
+
+	DefaultCommitSize = 1000
+	// entries committed at once, the next one is collected meanwhile
+	commitInflight = 2
 )
 
 type dbApply interface {
 	Apply(events []contract.Event) error
 }
 
-func raftApply(raft *raft.Raft, db dbApply, events []contract.Event) error {
-	if raft != nil {
-		b, err := json.Marshal(events)
-		if err != nil {
-			return err
+func raftApply(c *Committer, db dbApply, events []contract.Event) error {
+	if c != nil {
+		start := time.Now()
+		err := c.Commit(events)
+		if c.observe != nil {
+			c.observe(time.Since(start), err)
 		}
-
-		f := raft.Apply(b, raftTimeout)
-		return f.Error()
+		return err
 	} else {
 		return db.Apply(events)
 	}
 }
+
+// applyEntry writes events as a single raft log entry and waits for the fsm.
+func applyEntry(r *raft.Raft, events []contract.Event) error {
+	f, err := enqueueEntry(r, events)
+	if err != nil {
+		return err
+	}
+	return waitEntry(f)
+}
+
+func enqueueEntry(r *raft.Raft, events []contract.Event) (raft.ApplyFuture, error) {
+	b, err := json.Marshal(events)
+	if err != nil {
+		return nil, err
+	}
+	return r.Apply(b, raftTimeout), nil
+}
+
+func waitEntry(f raft.ApplyFuture) error {
+	if err := f.Error(); err != nil {
+		return err
+	}
+	// the fsm reports its own failures through the response
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+	return nil
+}
+
+type commit struct {
+	events []contract.Event
+	done   chan error
+}
+
+// Committer coalesces events of concurrent commands into one raft log entry.
+// An entry takes everything queued while the previous one was committed,
+// window additionally waits for more commands, up to size events.
+type Committer struct {
+	raft     *raft.Raft
+	window   time.Duration
+	size     int
+	pending  chan commit
+	inflight chan struct{}
+	quit     chan struct{}
+	observe  func(d time.Duration, err error)
+}
+
+// NewCommitter returns nil without raft, commands apply to the db directly.
+func NewCommitter(r *raft.Raft, window time.Duration, size int) *Committer {
+	if r == nil {
+		return nil
+	}
+	if size <= 0 {
+		size = DefaultCommitSize
+	}
+	c := &Committer{
+		raft:     r,
+		window:   window,
+		size:     size,
+		pending:  make(chan commit, size),
+		inflight: make(chan struct{}, commitInflight),
+		quit:     make(chan struct{}),
+	}
+	go c.run()
+	return c
+}
+
+// Observe is told how long every command waited for its events to be applied,
+// it has to be set before the committer is used.
+func (c *Committer) Observe(f func(d time.Duration, err error)) {
+	if c != nil {
+		c.observe = f
+	}
+}
+
+func (c *Committer) Close() {
+	close(c.quit)
+}
+
+func (c *Committer) Leader() bool {
+	return c.raft.State() == raft.Leader
+}
+
+// Commit blocks until the entry holding events is applied, a failed entry
+// fails every command in it.
+func (c *Committer) Commit(events []contract.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	cm := commit{events: events, done: make(chan error, 1)}
+	select {
+	case c.pending <- cm:
+	case <-c.quit:
+		return raft.ErrRaftShutdown
+	}
+	return <-cm.done
+}
+
+func (c *Committer) run() {
+	for {
+		select {
+		case c.inflight <- struct{}{}:
+		case <-c.quit:
+			return
+		}
+		var first commit
+		select {
+		case first = <-c.pending:
+		case <-c.quit:
+			return
+		}
+
+		batch := c.collect([]commit{first}, len(first.events))
+		var events []contract.Event
+		if len(batch) == 1 {
+			events = first.events
+		} else {
+			for _, cm := range batch {
+				events = append(events, cm.events...)
+			}
+		}
+
+		// raft keeps the order of entries, callers are answered out of this loop
+		f, err := enqueueEntry(c.raft, events)
+		go func() {
+			if err == nil {
+				err = waitEntry(f)
+			}
+			<-c.inflight
+			for _, cm := range batch {
+				cm.done <- err
+			}
+		}()
+	}
+}
+
+func (c *Committer) collect(batch []commit, n int) []commit {
+	var deadline <-chan time.Time
+	if c.window > 0 {
+		t := time.NewTimer(c.window)
+		defer t.Stop()
+		deadline = t.C
+	}
+	for n < c.size {
+		if deadline == nil {
+			select {
+			case cm := <-c.pending:
+				batch = append(batch, cm)
+				n += len(cm.events)
+			default:
+				return batch
+			}
+		} else {
+			select {
+			case cm := <-c.pending:
+				batch = append(batch, cm)
+				n += len(cm.events)
+			case <-deadline:
+				return batch
+			}
+		}
+	}
+	return batch
+}
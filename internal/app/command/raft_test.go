@@ -0,0 +1,157 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+)
+
+type countFsm struct {
+	entries atomic.Int64
+	events  atomic.Int64
+}
+
+func (f *countFsm) Apply(l *raft.Log) any {
+	var events []contract.Event
+	if err := json.Unmarshal(l.Data, &events); err != nil {
+		return err
+	}
+	f.entries.Add(1)
+	f.events.Add(int64(len(events)))
+	return nil
+}
+
+func (f *countFsm) Snapshot() (raft.FSMSnapshot, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (f *countFsm) Restore(io.ReadCloser) error {
+	return nil
+}
+
+func newTestRaft(tb testing.TB) (*raft.Raft, *countFsm) {
+	dir := tb.TempDir()
+	store, err := raftboltdb.NewBoltStore(path.Join(dir, "bolt"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	_, transport := raft.NewInmemTransport("")
+	cfg := raft.DefaultConfig()
+	cfg.LocalID = "n1"
+	cfg.HeartbeatTimeout = 50 * time.Millisecond
+	cfg.ElectionTimeout = 50 * time.Millisecond
+	cfg.LeaderLeaseTimeout = 50 * time.Millisecond
+	cfg.Logger = nil
+	cfg.LogOutput = io.Discard
+
+	fsm := &countFsm{}
+	r, err := raft.NewRaft(cfg, fsm, store, store, raft.NewDiscardSnapshotStore(), transport)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	r.BootstrapCluster(raft.Configuration{Servers: []raft.Server{{ID: cfg.LocalID, Address: transport.LocalAddr()}}})
+	tb.Cleanup(func() {
+		r.Shutdown().Error()
+		store.Close()
+		os.RemoveAll(dir)
+	})
+
+	select {
+	case <-r.LeaderCh():
+	case <-time.After(5 * time.Second):
+		tb.Fatal("raft leader not elected")
+	}
+	return r, fsm
+}
+
+func testEvents(i int) []contract.Event {
+	return []contract.Event{{Type: contract.SetType, Key: fmt.Appendf(nil, "k-%d", i), Value: []byte("v")}}
+}
+
+func TestCommitter_Commit(t *testing.T) {
+	r, fsm := newTestRaft(t)
+	c := NewCommitter(r, 5*time.Millisecond, 100)
+	defer c.Close()
+
+	const n = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.Commit(testEvents(i))
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("not correct commit error %v", err)
+		}
+	}
+
+	if fsm.events.Load() != n {
+		t.Errorf("not correct count applied events %d", fsm.events.Load())
+	}
+	if fsm.entries.Load() >= n {
+		t.Errorf("commands not coalesced, entries %d", fsm.entries.Load())
+	}
+	if err := c.Commit(nil); err != nil {
+		t.Errorf("not correct empty commit %v", err)
+	}
+}
+
+func TestCommitter_Nil(t *testing.T) {
+	if NewCommitter(nil, 0, 0) != nil {
+		t.Errorf("committer without raft must be nil")
+	}
+}
+
+func BenchmarkRaftApply(b *testing.B) {
+	b.Run("entry-per-command", func(b *testing.B) {
+		r, _ := newTestRaft(b)
+		var i atomic.Int64
+		b.SetParallelism(16)
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if err := applyEntry(r, testEvents(int(i.Add(1)))); err != nil {
+					b.Error(err)
+				}
+			}
+		})
+	})
+
+	for _, window := range []time.Duration{0, time.Millisecond} {
+		b.Run(fmt.Sprintf("group-commit-window-%v", window), func(b *testing.B) {
+			r, fsm := newTestRaft(b)
+			c := NewCommitter(r, window, DefaultCommitSize)
+			defer c.Close()
+			var i atomic.Int64
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if err := c.Commit(testEvents(int(i.Add(1)))); err != nil {
+						b.Error(err)
+					}
+				}
+			})
+			b.StopTimer()
+			if entries := fsm.entries.Load(); entries > 0 {
+				b.ReportMetric(float64(fsm.events.Load())/float64(entries), "events/entry")
+			}
+		})
+	}
+}
@@ -1,15 +1,18 @@
 package command
 
 import (
+	"context"
 	"errors"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
 type SearchDeleteErrorTaskDbAdapter interface {
 	SearchErrorTask(
+		ctx context.Context,
 		condition *contract.Condition,
 		kind *string,
 		size *uint,
@@ -19,7 +22,16 @@ type SearchDeleteErrorTaskDbAdapter interface {
 }
 
 type SearchDeleteErrorTaskClusterAdapter interface {
+	SearchErrorTask(
+		ctx context.Context,
+		url string,
+		condition *contract.Condition,
+		kind *string,
+		size *uint,
+	) (tasks []contract.Task, err error)
+
 	SearchDeleteErrorTask(
+		ctx context.Context,
 		url string,
 		condition *contract.Condition,
 		kind *string,
@@ -33,7 +45,9 @@ type SearchDeleteErrorTaskHandler struct {
 	ring    *hashring.HashRing
 	curUrl  string
 	nodes   []string
-	raft    *raft.Raft
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
 }
 
 func NewSearchDeleteErrorTaskHandler(
@@ -42,7 +56,9 @@ func NewSearchDeleteErrorTaskHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
-	raft *raft.Raft,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
 ) (h SearchDeleteErrorTaskHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil SearchDeleteErrorTaskDbAdapter")
@@ -67,10 +83,13 @@ func NewSearchDeleteErrorTaskHandler(
 		curUrl:  url,
 		nodes:   nodes,
 		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
 	}, nil
 }
 
 func (h SearchDeleteErrorTaskHandler) Handle(
+	ctx context.Context,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
@@ -79,37 +98,51 @@ func (h SearchDeleteErrorTaskHandler) Handle(
 	if condition != nil && len(condition.Operations) == 0 && len(condition.Conditions) == 0 {
 		return errors.New("condition is empty")
 	}
+	if err = h.policy.Allow(ctx, access.SEARCH_DELETE, access.Kind(kind)); err != nil {
+		return err
+	}
 
 	if internal {
-		return h.internal(condition, kind, size)
+		return h.internal(ctx, condition, kind, size)
 	}
 
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			err = h.internal(condition, kind, size)
-			if err != nil {
-				return err
+	// size is of the whole cluster, every node changes its share of it
+	nodes, sizes, err := fanout.Shares(ctx, h.fanout, h.nodes, size,
+		func(ctx context.Context, node string) ([]contract.Task, error) {
+			if node == h.curUrl {
+				return h.db.SearchErrorTask(ctx, condition, kind, size)
 			}
-		} else {
-			err = h.cluster.SearchDeleteErrorTask(node, condition, kind, size)
-			if err != nil {
-				return err
-			}
-		}
+			return h.cluster.SearchErrorTask(ctx, node, condition, kind, size)
+		},
+	)
+	if err != nil {
+		return err
 	}
-	return nil
+	results := fanout.Run(ctx, h.fanout, nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.internal(ctx, condition, kind, sizes[node])
+			}
+			return struct{}{}, h.cluster.SearchDeleteErrorTask(ctx, node, condition, kind, sizes[node])
+		},
+	)
+	return fanout.FirstError(results)
 }
 
 func (h SearchDeleteErrorTaskHandler) internal(
+	ctx context.Context,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 ) (err error) {
-	portion, err := h.db.SearchErrorTask(condition, kind, size)
+	portion, err := h.db.SearchErrorTask(ctx, condition, kind, size)
 	if err != nil {
 		return err
 	}
 	for _, task := range portion {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		events, err := h.db.DeleteError(task.Id)
 		if err != nil {
 			return err
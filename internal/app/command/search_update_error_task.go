@@ -1,16 +1,19 @@
 package command
 
 import (
+	"context"
 	"errors"
 	"maps"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
 type SearchUpdateErrorTaskDbAdapter interface {
 	SearchErrorTask(
+		ctx context.Context,
 		condition *contract.Condition,
 		kind *string,
 		size *uint,
@@ -24,7 +27,16 @@ type SearchUpdateErrorTaskDbAdapter interface {
 }
 
 type SearchUpdateErrorTaskClusterAdapter interface {
+	SearchErrorTask(
+		ctx context.Context,
+		url string,
+		condition *contract.Condition,
+		kind *string,
+		size *uint,
+	) (tasks []contract.Task, err error)
+
 	SearchUpdateErrorTask(
+		ctx context.Context,
 		url string,
 		up contract.TaskUpdate,
 		condition *contract.Condition,
@@ -39,7 +51,9 @@ type SearchUpdateErrorTaskHandler struct {
 	ring    *hashring.HashRing
 	curUrl  string
 	nodes   []string
-	raft    *raft.Raft
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
 }
 
 func NewSearchUpdateErrorTaskHandler(
@@ -48,7 +62,9 @@ func NewSearchUpdateErrorTaskHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
-	raft *raft.Raft,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
 ) (h SearchUpdateErrorTaskHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil SearchUpdateErrorTaskDbAdapter")
@@ -73,10 +89,13 @@ func NewSearchUpdateErrorTaskHandler(
 		curUrl:  url,
 		nodes:   nodes,
 		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
 	}, nil
 }
 
 func (h SearchUpdateErrorTaskHandler) Handle(
+	ctx context.Context,
 	up contract.TaskUpdate,
 	condition *contract.Condition,
 	kind *string,
@@ -86,38 +105,52 @@ func (h SearchUpdateErrorTaskHandler) Handle(
 	if condition != nil && len(condition.Operations) == 0 && len(condition.Conditions) == 0 {
 		return errors.New("condition is empty")
 	}
+	if err = h.policy.Allow(ctx, access.SEARCH_UPDATE, access.Kind(kind)); err != nil {
+		return err
+	}
 
 	if internal {
-		return h.internal(up, condition, kind, size)
+		return h.internal(ctx, up, condition, kind, size)
 	}
 
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			err = h.internal(up, condition, kind, size)
-			if err != nil {
-				return err
+	// size is of the whole cluster, every node changes its share of it
+	nodes, sizes, err := fanout.Shares(ctx, h.fanout, h.nodes, size,
+		func(ctx context.Context, node string) ([]contract.Task, error) {
+			if node == h.curUrl {
+				return h.db.SearchErrorTask(ctx, condition, kind, size)
 			}
-		} else {
-			err = h.cluster.SearchUpdateErrorTask(node, up, condition, kind, size)
-			if err != nil {
-				return err
-			}
-		}
+			return h.cluster.SearchErrorTask(ctx, node, condition, kind, size)
+		},
+	)
+	if err != nil {
+		return err
 	}
-	return nil
+	results := fanout.Run(ctx, h.fanout, nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.internal(ctx, up, condition, kind, sizes[node])
+			}
+			return struct{}{}, h.cluster.SearchUpdateErrorTask(ctx, node, up, condition, kind, sizes[node])
+		},
+	)
+	return fanout.FirstError(results)
 }
 
 func (h SearchUpdateErrorTaskHandler) internal(
+	ctx context.Context,
 	up contract.TaskUpdate,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 ) (err error) {
-	portion, err := h.db.SearchErrorTask(condition, kind, size)
+	portion, err := h.db.SearchErrorTask(ctx, condition, kind, size)
 	if err != nil {
 		return err
 	}
 	for _, task := range portion {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if up.Status != nil {
 			task.Status = *up.Status
 		}
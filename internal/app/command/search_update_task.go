@@ -1,16 +1,19 @@
 package command
 
 import (
+	"context"
 	"errors"
 	"maps"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
 type SearchUpdateTaskDbAdapter interface {
 	SearchTask(
+		ctx context.Context,
 		condition *contract.Condition,
 		kind *string,
 		size *uint,
@@ -30,7 +33,16 @@ type SearchUpdateTaskDbAdapter interface {
 }
 
 type SearchUpdateTaskClusterAdapter interface {
+	SearchTask(
+		ctx context.Context,
+		url string,
+		condition *contract.Condition,
+		kind *string,
+		size *uint,
+	) (tasks []contract.Task, err error)
+
 	SearchUpdateTask(
+		ctx context.Context,
 		url string,
 		up contract.TaskUpdate,
 		condition *contract.Condition,
@@ -39,6 +51,7 @@ type SearchUpdateTaskClusterAdapter interface {
 	) (err error)
 
 	Add(
+		ctx context.Context,
 		url string,
 		group string,
 		kind string,
@@ -53,7 +66,9 @@ type SearchUpdateTaskHandler struct {
 	ring    *hashring.HashRing
 	curUrl  string
 	nodes   []string
-	raft    *raft.Raft
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
 }
 
 func NewSearchUpdateTaskHandler(
@@ -62,7 +77,9 @@ func NewSearchUpdateTaskHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
-	raft *raft.Raft,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
 ) (h SearchUpdateTaskHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil SearchUpdateTaskDbAdapter")
@@ -87,10 +104,13 @@ func NewSearchUpdateTaskHandler(
 		curUrl:  url,
 		nodes:   nodes,
 		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
 	}, nil
 }
 
 func (h SearchUpdateTaskHandler) Handle(
+	ctx context.Context,
 	up contract.TaskUpdate,
 	condition *contract.Condition,
 	kind *string,
@@ -100,38 +120,52 @@ func (h SearchUpdateTaskHandler) Handle(
 	if condition != nil && len(condition.Operations) == 0 && len(condition.Conditions) == 0 {
 		return errors.New("condition is empty")
 	}
+	if err = h.policy.Allow(ctx, access.SEARCH_UPDATE, access.Kind(kind)); err != nil {
+		return err
+	}
 
 	if internal {
-		return h.internal(up, condition, kind, size)
+		return h.internal(ctx, up, condition, kind, size)
 	}
 
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			err = h.internal(up, condition, kind, size)
-			if err != nil {
-				return err
+	// size is of the whole cluster, every node changes its share of it
+	nodes, sizes, err := fanout.Shares(ctx, h.fanout, h.nodes, size,
+		func(ctx context.Context, node string) ([]contract.Task, error) {
+			if node == h.curUrl {
+				return h.db.SearchTask(ctx, condition, kind, size)
 			}
-		} else {
-			err = h.cluster.SearchUpdateTask(node, up, condition, kind, size)
-			if err != nil {
-				return err
-			}
-		}
+			return h.cluster.SearchTask(ctx, node, condition, kind, size)
+		},
+	)
+	if err != nil {
+		return err
 	}
-	return nil
+	results := fanout.Run(ctx, h.fanout, nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.internal(ctx, up, condition, kind, sizes[node])
+			}
+			return struct{}{}, h.cluster.SearchUpdateTask(ctx, node, up, condition, kind, sizes[node])
+		},
+	)
+	return fanout.FirstError(results)
 }
 
 func (h SearchUpdateTaskHandler) internal(
+	ctx context.Context,
 	up contract.TaskUpdate,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 ) (err error) {
-	portion, err := h.db.SearchTask(condition, kind, size)
+	portion, err := h.db.SearchTask(ctx, condition, kind, size)
 	if err != nil {
 		return err
 	}
 	for _, task := range portion {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		isNew := false
 		if up.Status != nil {
 			task.Status = *up.Status
@@ -167,7 +201,7 @@ func (h SearchUpdateTaskHandler) internal(
 		} else {
 			// order is important to not lose the task
 			// if the outcome is bad there may be a duplicate
-			_, err = h.cluster.Add(h.curUrl, task.Group, task.Kind, task.Owner, task.Param)
+			_, err = h.cluster.Add(ctx, h.curUrl, task.Group, task.Kind, task.Owner, task.Param)
 			if err != nil {
 				return err
 			}
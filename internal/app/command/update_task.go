@@ -1,11 +1,12 @@
 package command
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
-	"github.com/hashicorp/raft"
 	"github.com/serialx/hashring"
 )
 
@@ -22,6 +23,7 @@ type UpdateTaskDbAdapter interface {
 
 type UpdateTaskClusterAdapter interface {
 	Update(
+		ctx context.Context,
 		url string,
 		group string,
 		id string,
@@ -36,7 +38,8 @@ type UpdateTaskHandler struct {
 	cluster UpdateTaskClusterAdapter
 	ring    *hashring.HashRing
 	curUrl  string
-	raft    *raft.Raft
+	raft    *Committer
+	policy  *access.Policy
 }
 
 func NewUpdateTaskHandler(
@@ -44,7 +47,8 @@ func NewUpdateTaskHandler(
 	cluster UpdateTaskClusterAdapter,
 	ring *hashring.HashRing,
 	url string,
-	raft *raft.Raft,
+	raft *Committer,
+	policy *access.Policy,
 ) (h UpdateTaskHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil updateTaskAdapter")
@@ -65,10 +69,12 @@ func NewUpdateTaskHandler(
 		ring:    ring,
 		curUrl:  url,
 		raft:    raft,
+		policy:  policy,
 	}, nil
 }
 
 func (h UpdateTaskHandler) Handle(
+	ctx context.Context,
 	group string,
 	id string,
 	status contract.Status,
@@ -84,6 +90,9 @@ func (h UpdateTaskHandler) Handle(
 	if status == 0 {
 		return errors.New("status is empty")
 	}
+	if err = h.policy.AllowTasks(ctx, access.UPDATE, id); err != nil {
+		return err
+	}
 
 	node, exists := h.ring.GetNode(group)
 	if !exists {
@@ -101,6 +110,6 @@ func (h UpdateTaskHandler) Handle(
 		}
 		return raftApply(h.raft, h.db, events)
 	} else {
-		return h.cluster.Update(node, group, id, status, param, error)
+		return h.cluster.Update(ctx, node, group, id, status, param, error)
 	}
 }
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/serialx/hashring"
+)
+
+type UpdateTaskBatchClusterAdapter interface {
+	UpdateBatch(
+		ctx context.Context,
+		url string,
+		tasks []contract.UpdateRequest,
+	) (items []contract.BatchItem, err error)
+}
+
+type UpdateTaskBatchHandler struct {
+	db      UpdateTaskDbAdapter
+	cluster UpdateTaskBatchClusterAdapter
+	ring    *hashring.HashRing
+	curUrl  string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewUpdateTaskBatchHandler(
+	db UpdateTaskDbAdapter,
+	cluster UpdateTaskBatchClusterAdapter,
+	ring *hashring.HashRing,
+	url string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h UpdateTaskBatchHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil UpdateTaskDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil UpdateTaskBatchClusterAdapter")
+	}
+	if ring == nil {
+		return h, errors.New("nil ring")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+
+	return UpdateTaskBatchHandler{
+		db:      db,
+		cluster: cluster,
+		ring:    ring,
+		curUrl:  url,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle updates tasks the same way as UpdateTaskHandler, every item is
+// read before the batch is committed so a task must not repeat in a batch.
+func (h UpdateTaskBatchHandler) Handle(
+	ctx context.Context,
+	tasks []contract.UpdateRequest,
+) (items []contract.BatchItem, err error) {
+	ids := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.Id)
+	}
+	if err = h.policy.AllowTasks(ctx, access.UPDATE, ids...); err != nil {
+		return nil, err
+	}
+	return runBatch(ctx, h.fanout, h.ring, h.curUrl, tasks,
+		func(t contract.UpdateRequest) string { return t.Group },
+		h.updateLocal,
+		h.cluster.UpdateBatch,
+	)
+}
+
+// updateLocal commits the updates of the current node in a single raft entry.
+func (h UpdateTaskBatchHandler) updateLocal(tasks []contract.UpdateRequest) (items []contract.BatchItem) {
+	items = make([]contract.BatchItem, len(tasks))
+	var events []contract.Event
+	var updated []int
+	seen := make(map[string]bool, len(tasks))
+	for i, t := range tasks {
+		if t.Id == "" {
+			batchError(items, []int{i}, errors.New("id is empty"))
+			continue
+		}
+		if t.Status == 0 {
+			batchError(items, []int{i}, errors.New("status is empty"))
+			continue
+		}
+		if seen[t.Id] {
+			batchError(items, []int{i}, errors.New("id repeats in batch"))
+			continue
+		}
+		seen[t.Id] = true
+
+		status := contract.Status(t.Status)
+		var offset *string
+		if status == contract.COMPLETED || status == contract.FAILED {
+			offset = &t.Id
+		}
+		e, err := h.db.Update(t.Id, status, t.Param, t.Error, offset)
+		if err != nil {
+			batchError(items, []int{i}, err)
+			continue
+		}
+		items[i].Id = t.Id
+		events = append(events, e...)
+		updated = append(updated, i)
+	}
+
+	if len(events) > 0 {
+		if err := raftApply(h.raft, h.db, events); err != nil {
+			batchError(items, updated, err)
+		}
+	}
+	return items
+}
@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type WebhookRegDbAdapter interface {
+	WebhookReg(webhook contract.Webhook) (events []contract.Event, err error)
+	Apply(events []contract.Event) (err error)
+}
+
+type WebhookRegClusterAdapter interface {
+	WebhookReg(ctx context.Context, url string, webhook contract.Webhook) (err error)
+}
+
+type WebhookRegHandler struct {
+	db      WebhookRegDbAdapter
+	cluster WebhookRegClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewWebhookRegHandler(
+	db WebhookRegDbAdapter,
+	cluster WebhookRegClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h WebhookRegHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil WebhookRegDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil WebhookRegClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return WebhookRegHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle stores the subscription on every node, tasks of any group can trigger it.
+// A webhook registered again with the same id is replaced.
+func (h WebhookRegHandler) Handle(
+	ctx context.Context,
+	webhook contract.Webhook,
+	internal bool,
+) (id string, err error) {
+	kinds := webhook.Kinds
+	if len(kinds) == 0 {
+		kinds = []string{""}
+	}
+	if err = h.policy.Allow(ctx, access.WEBHOOK_REG, kinds...); err != nil {
+		return id, err
+	}
+	u, err := url.Parse(webhook.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return id, errors.New("webhook url is not valid")
+	}
+	for _, s := range webhook.Statuses {
+		if s != contract.COMPLETED && s != contract.FAILED && s != contract.CANCELLED {
+			return id, fmt.Errorf("webhook status is not supported: %v", s)
+		}
+	}
+	if webhook.Id == "" {
+		if internal {
+			return id, errors.New("webhook id is empty")
+		}
+		b := make([]byte, 8)
+		if _, err = rand.Read(b); err != nil {
+			return id, fmt.Errorf("webhook id error: %v", err)
+		}
+		webhook.Id = hex.EncodeToString(b)
+	}
+
+	if internal {
+		events, err := h.db.WebhookReg(webhook)
+		if err != nil {
+			return id, err
+		}
+		return webhook.Id, raftApply(h.raft, h.db, events)
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				events, err := h.db.WebhookReg(webhook)
+				if err != nil {
+					return struct{}{}, err
+				}
+				return struct{}{}, raftApply(h.raft, h.db, events)
+			}
+			return struct{}{}, h.cluster.WebhookReg(ctx, node, webhook)
+		},
+	)
+	return webhook.Id, fanout.FirstError(results)
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type WebhookUnRegDbAdapter interface {
+	WebhookUnReg(id string) (events []contract.Event)
+	Apply(events []contract.Event) (err error)
+}
+
+type WebhookUnRegClusterAdapter interface {
+	WebhookUnReg(ctx context.Context, url string, id string) (err error)
+}
+
+type WebhookUnRegHandler struct {
+	db      WebhookUnRegDbAdapter
+	cluster WebhookUnRegClusterAdapter
+	curUrl  string
+	nodes   []string
+	raft    *Committer
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewWebhookUnRegHandler(
+	db WebhookUnRegDbAdapter,
+	cluster WebhookUnRegClusterAdapter,
+	url string,
+	nodes []string,
+	raft *Committer,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h WebhookUnRegHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil WebhookUnRegDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil WebhookUnRegClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return WebhookUnRegHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		raft:    raft,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+func (h WebhookUnRegHandler) Handle(
+	ctx context.Context,
+	id string,
+	internal bool,
+) (err error) {
+	if id == "" {
+		return errors.New("webhook id is empty")
+	}
+	if err = h.policy.Allow(ctx, access.WEBHOOK_UNREG); err != nil {
+		return err
+	}
+
+	if internal {
+		return raftApply(h.raft, h.db, h.db.WebhookUnReg(id))
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, raftApply(h.raft, h.db, h.db.WebhookUnReg(id))
+			}
+			return struct{}{}, h.cluster.WebhookUnReg(ctx, node, id)
+		},
+	)
+	return fanout.FirstError(results)
+}
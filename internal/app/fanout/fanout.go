@@ -0,0 +1,247 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	DefaultTimeout = 10 * time.Second
+)
+
+type Result[T any] struct {
+	Node  string
+	Value T
+	Err   error
+}
+
+type Executor struct {
+	timeout time.Duration
+}
+
+func NewExecutor(timeout time.Duration) Executor {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return Executor{timeout: timeout}
+}
+
+func (e Executor) Timeout() time.Duration {
+	if e.timeout <= 0 {
+		return DefaultTimeout
+	}
+	return e.timeout
+}
+
+// Run calls every node concurrently, each under its own deadline derived from ctx.
+// Results are returned in the order of nodes regardless of completion order.
+func Run[T any](
+	ctx context.Context,
+	e Executor,
+	nodes []string,
+	call func(ctx context.Context, node string) (T, error),
+) []Result[T] {
+	results := make([]Result[T], len(nodes))
+	done := make(chan int, len(nodes))
+
+	for i, node := range nodes {
+		go func() {
+			results[i] = runNode(ctx, e.Timeout(), node, call)
+			done <- i
+		}()
+	}
+	for range nodes {
+		<-done
+	}
+
+	return results
+}
+
+func runNode[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	node string,
+	call func(ctx context.Context, node string) (T, error),
+) (r Result[T]) {
+	r.Node = node
+	nodeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// buffered so that a call outliving its deadline does not leak the goroutine
+	out := make(chan Result[T], 1)
+	go func() {
+		v, err := call(nodeCtx, node)
+		out <- Result[T]{Node: node, Value: v, Err: err}
+	}()
+
+	select {
+	case r = <-out:
+	case <-nodeCtx.Done():
+		r.Err = nodeCtx.Err()
+	}
+	return r
+}
+
+// FirstError returns the error of the first failed node in node order.
+func FirstError[T any](results []Result[T]) error {
+	for _, r := range results {
+		if r.Err != nil {
+			return r.Err
+		}
+	}
+	return nil
+}
+
+// Statuses reports per node outcome, a node that ran out of its deadline is a timeout.
+func Statuses[T any](results []Result[T]) (nodes []contract.NodeStatus) {
+	for _, r := range results {
+		status := contract.NodeStatus{Node: r.Node, State: contract.NodeOk}
+		if r.Err != nil {
+			msg := r.Err.Error()
+			status.State = contract.NodeError
+			status.Error = &msg
+			if errors.Is(r.Err, context.DeadlineExceeded) {
+				status.State = contract.NodeTimeout
+			}
+		}
+		nodes = append(nodes, status)
+	}
+	return nodes
+}
+
+// Partial returns an error only when no node answered.
+func Partial[T any](results []Result[T]) error {
+	for _, r := range results {
+		if r.Err == nil {
+			return nil
+		}
+	}
+	return FirstError(results)
+}
+
+// Merge keeps the first size tasks by id of the nodes streaming them while the
+// chunks arrive, the size is of the whole cluster.
+type Merge struct {
+	mu      sync.Mutex
+	size    *uint
+	nodes   map[string][]contract.Task
+	total   uint
+	dropped map[string]bool
+	done    bool
+}
+
+func NewMerge(size *uint) *Merge {
+	return &Merge{size: size, nodes: make(map[string][]contract.Task), dropped: make(map[string]bool)}
+}
+
+// Add returns the receiver of the chunks of node.
+func (m *Merge) Add(node string) func(tasks []contract.Task) error {
+	return func(tasks []contract.Task) error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.done || m.dropped[node] {
+			return nil
+		}
+		m.nodes[node] = append(m.nodes[node], tasks...)
+		m.total += uint(len(tasks))
+		// the tasks behind size are cut once they double it
+		if m.size != nil && m.total > 2**m.size {
+			m.trim()
+		}
+		return nil
+	}
+}
+
+// Drop leaves out the tasks of a failed node, tasks of other nodes already
+// cut for them are not brought back.
+func (m *Merge) Drop(node string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.total -= uint(len(m.nodes[node]))
+	delete(m.nodes, node)
+	m.dropped[node] = true
+}
+
+// Tasks returns the merged tasks sorted by id, later chunks are ignored.
+func (m *Merge) Tasks() (tasks []contract.Task) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.done = true
+	for _, t := range m.first() {
+		tasks = append(tasks, t.task)
+	}
+	return tasks
+}
+
+func (m *Merge) trim() {
+	first := m.first()
+	clear(m.nodes)
+	for _, t := range first {
+		m.nodes[t.node] = append(m.nodes[t.node], t.task)
+	}
+	m.total = uint(len(first))
+}
+
+// first sorts the tasks of the nodes by id and keeps size of them.
+func (m *Merge) first() (tasks []nodeTask) {
+	for node, nodeTasks := range m.nodes {
+		for _, t := range nodeTasks {
+			tasks = append(tasks, nodeTask{node: node, task: t})
+		}
+	}
+	slices.SortStableFunc(tasks, func(a, b nodeTask) int { return strings.Compare(a.task.Id, b.task.Id) })
+	if m.size != nil && uint(len(tasks)) > *m.size {
+		tasks = tasks[:*m.size]
+	}
+	return tasks
+}
+
+// Shares searches the tasks a change would touch on every node and gives each
+// node its share of size, the count of its tasks among the first size tasks
+// of the cluster by id. Nodes without a share are left out, without a size
+// every node is called without a limit.
+func Shares(
+	ctx context.Context,
+	e Executor,
+	nodes []string,
+	size *uint,
+	search func(ctx context.Context, node string) ([]contract.Task, error),
+) (shared []string, sizes map[string]*uint, err error) {
+	if size == nil {
+		return nodes, map[string]*uint{}, nil
+	}
+	results := Run(ctx, e, nodes, search)
+	if err = FirstError(results); err != nil {
+		return nil, nil, err
+	}
+	merge := NewMerge(size)
+	for _, r := range results {
+		merge.Add(r.Node)(r.Value)
+	}
+	sizes = make(map[string]*uint)
+	for _, t := range merge.first() {
+		n, ok := sizes[t.node]
+		if !ok {
+			n = new(uint)
+			sizes[t.node] = n
+		}
+		*n++
+	}
+	for _, node := range nodes {
+		if _, ok := sizes[node]; ok {
+			shared = append(shared, node)
+		}
+	}
+	return shared, sizes, nil
+}
+
+type nodeTask struct {
+	node string
+	task contract.Task
+}
@@ -0,0 +1,153 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestFanout_RunOrder(t *testing.T) {
+	nodes := []string{"a", "b", "c"}
+	delays := map[string]time.Duration{"a": 30 * time.Millisecond, "b": 0, "c": 10 * time.Millisecond}
+
+	results := Run(context.Background(), NewExecutor(time.Second), nodes,
+		func(ctx context.Context, node string) (string, error) {
+			time.Sleep(delays[node])
+			return node, nil
+		},
+	)
+
+	if len(results) != len(nodes) {
+		t.Fatalf("not correct count results %d", len(results))
+	}
+	for i, r := range results {
+		if r.Node != nodes[i] || r.Value != nodes[i] || r.Err != nil {
+			t.Errorf("not correct result %d: %+v", i, r)
+		}
+	}
+}
+
+func TestFanout_RunTimeout(t *testing.T) {
+	start := time.Now()
+	results := Run(context.Background(), NewExecutor(20*time.Millisecond), []string{"fast", "slow"},
+		func(ctx context.Context, node string) (int, error) {
+			if node == "slow" {
+				time.Sleep(time.Second)
+			}
+			return 1, nil
+		},
+	)
+
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("fan-out did not respect node deadline")
+	}
+	if results[0].Err != nil {
+		t.Errorf("not correct fast node error %v", results[0].Err)
+	}
+	if !errors.Is(results[1].Err, context.DeadlineExceeded) {
+		t.Errorf("not correct slow node error %v", results[1].Err)
+	}
+	if !errors.Is(FirstError(results), context.DeadlineExceeded) {
+		t.Errorf("not correct first error")
+	}
+}
+
+func TestFanout_Statuses(t *testing.T) {
+	results := []Result[int]{
+		{Node: "a", Value: 1},
+		{Node: "b", Err: context.DeadlineExceeded},
+		{Node: "c", Err: errors.New("down")},
+	}
+
+	nodes := Statuses(results)
+	if len(nodes) != 3 {
+		t.Fatalf("not correct count statuses %d", len(nodes))
+	}
+	if nodes[0].State != contract.NodeOk || nodes[0].Error != nil {
+		t.Errorf("not correct ok status %+v", nodes[0])
+	}
+	if nodes[1].State != contract.NodeTimeout {
+		t.Errorf("not correct timeout status %+v", nodes[1])
+	}
+	if nodes[2].State != contract.NodeError || *nodes[2].Error != "down" {
+		t.Errorf("not correct error status %+v", nodes[2])
+	}
+
+	if err := Partial(results); err != nil {
+		t.Errorf("partial must succeed while a node answered: %v", err)
+	}
+	if err := Partial(results[1:]); err == nil {
+		t.Errorf("partial must fail when no node answered")
+	}
+}
+
+func TestFanout_Merge(t *testing.T) {
+	tasks := func(ids ...string) (res []contract.Task) {
+		for _, id := range ids {
+			res = append(res, contract.Task{Id: id})
+		}
+		return res
+	}
+	results := []Result[[]contract.Task]{
+		{Node: "a", Value: tasks("t-1", "t-4", "t-5")},
+		{Node: "b", Value: tasks("t-2", "t-3")},
+		{Node: "c", Err: errors.New("down")},
+	}
+
+	merged := func(size *uint) []contract.Task {
+		m := NewMerge(size)
+		for _, r := range results {
+			// chunks of one task arrive in turn
+			for _, task := range r.Value {
+				m.Add(r.Node)([]contract.Task{task})
+			}
+			if r.Err != nil {
+				m.Drop(r.Node)
+			}
+		}
+		return m.Tasks()
+	}
+	size := uint(3)
+	got := merged(&size)
+	if len(got) != 3 || got[0].Id != "t-1" || got[1].Id != "t-2" || got[2].Id != "t-3" {
+		t.Errorf("not correct limited tasks %+v", got)
+	}
+	if got = merged(nil); len(got) != 5 {
+		t.Errorf("not correct tasks without size %+v", got)
+	}
+
+	m := NewMerge(&size)
+	m.Add("a")(tasks("t-1", "t-2"))
+	m.Add("c")(tasks("t-0"))
+	m.Drop("c")
+	m.Add("c")(tasks("t-00"))
+	if got = m.Tasks(); len(got) != 2 || got[0].Id != "t-1" {
+		t.Errorf("not correct tasks of dropped node %+v", got)
+	}
+	if m.Add("b")(tasks("t-0")); len(m.Tasks()) != 2 {
+		t.Errorf("not correct chunk after tasks")
+	}
+
+	nodes, sizes, err := Shares(context.Background(), NewExecutor(time.Second), []string{"a", "b", "c"}, &size,
+		func(ctx context.Context, node string) ([]contract.Task, error) {
+			for _, r := range results {
+				if r.Node == node {
+					return r.Value, nil
+				}
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 || nodes[0] != "a" || nodes[1] != "b" || *sizes["a"] != 1 || *sizes["b"] != 2 {
+		t.Errorf("not correct shares %v %v", nodes, sizes)
+	}
+	if nodes, sizes, _ = Shares(context.Background(), NewExecutor(time.Second), []string{"a", "b"}, nil, nil); len(nodes) != 2 || sizes["a"] != nil {
+		t.Errorf("not correct shares without size %v %v", nodes, sizes)
+	}
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type NamespacesDbAdapter interface {
+	HasNamespace(name string) bool
+	Namespaces() (namespaces []contract.Namespace)
+}
+
+// Namespaces holds the application of every namespace, the application of a
+// namespace is built on its first request and kept when it is dropped.
+type Namespaces struct {
+	def   Application
+	db    NamespacesDbAdapter
+	build func(ns string) (Application, error)
+	mu    sync.Mutex
+	apps  map[string]Application
+}
+
+func NewNamespaces(def Application, db NamespacesDbAdapter, build func(ns string) (Application, error)) (*Namespaces, error) {
+	if db == nil {
+		return nil, errors.New("nil NamespacesDbAdapter")
+	}
+	if build == nil {
+		return nil, errors.New("nil build")
+	}
+	return &Namespaces{def: def, db: db, build: build, apps: make(map[string]Application)}, nil
+}
+
+// Default is the application of the default namespace.
+func (n *Namespaces) Default() Application {
+	return n.def
+}
+
+// Application returns the application of a namespace, the empty one is the default namespace.
+func (n *Namespaces) Application(ns string) (Application, error) {
+	if ns == "" {
+		return n.def, nil
+	}
+	if !n.db.HasNamespace(ns) {
+		return Application{}, fmt.Errorf("%w: %v", contract.ErrNamespaceNotFound, ns)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if a, ok := n.apps[ns]; ok {
+		return a, nil
+	}
+	a, err := n.build(ns)
+	if err != nil {
+		return Application{}, err
+	}
+	n.apps[ns] = a
+	return a, nil
+}
+
+// Each calls f with the application of every namespace, the default one first.
+func (n *Namespaces) Each(ctx context.Context, f func(ctx context.Context, a Application) error) error {
+	errs := []error{f(ctx, n.def)}
+	for _, ns := range n.db.Namespaces() {
+		a, err := n.Application(ns.Name)
+		if err == nil {
+			err = f(contract.WithNamespace(ctx, ns.Name), a)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("namespace %v: %w", ns.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,228 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type EventsDbAdapter interface {
+	Subscribe(
+		condition *contract.Condition,
+		from contract.EventCursor,
+	) (backlog []contract.TaskEvent, ch <-chan contract.TaskEvent, at contract.EventCursor, gap bool, cancel func())
+}
+
+type EventsClusterAdapter interface {
+	Events(
+		ctx context.Context,
+		url string,
+		condition *contract.Condition,
+		from contract.EventCursor,
+		send func(e contract.TaskEvent) error,
+	) (err error)
+}
+
+type EventsHandler struct {
+	db      EventsDbAdapter
+	cluster EventsClusterAdapter
+	curUrl  string
+	nodes   []string
+	policy  *access.Policy
+}
+
+func NewEventsHandler(
+	db EventsDbAdapter,
+	cluster EventsClusterAdapter,
+	url string,
+	nodes []string,
+	policy *access.Policy,
+) (h EventsHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil EventsDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil EventsClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return EventsHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		policy:  policy,
+	}, nil
+}
+
+// Cursor parses the cursor of a stream of this cluster.
+func (h EventsHandler) Cursor(s string) (contract.StreamCursor, error) {
+	return contract.ParseStreamCursor(s, len(h.nodes))
+}
+
+type nodeEvent struct {
+	node int
+	e    contract.TaskEvent
+}
+
+// Handle streams events of every node of the cluster applied after from until
+// ctx is done. Each event carries the cluster cursor to resume after it, the
+// stream starts with an EventsStart event once every node is subscribed. A
+// node that fails or a client too slow to keep up ends the stream.
+func (h EventsHandler) Handle(
+	ctx context.Context,
+	condition *contract.Condition,
+	from contract.StreamCursor,
+	send func(e contract.TaskEvent) error,
+) (err error) {
+	if send == nil {
+		return errors.New("nil send")
+	}
+	if len(from) != len(h.nodes) {
+		return fmt.Errorf("event cursor %q is not of a cluster of %d nodes", from, len(h.nodes))
+	}
+	if err = h.policy.Allow(ctx, access.EVENTS); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan nodeEvent)
+	done := make(chan error, len(h.nodes))
+	for i, node := range h.nodes {
+		go func() {
+			push := func(e contract.TaskEvent) error {
+				select {
+				case events <- nodeEvent{node: i, e: e}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			var err error
+			if node == h.curUrl {
+				err = h.local(ctx, condition, from[i], push)
+			} else {
+				err = h.cluster.Events(ctx, node, condition, from[i], push)
+			}
+			if err == nil {
+				err = fmt.Errorf("events of node %v ended", node)
+			}
+			done <- err
+		}()
+	}
+
+	cursor := slices.Clone(from)
+	started := make([]bool, len(h.nodes))
+	left := len(h.nodes)
+	// events of started nodes wait for the cursor of the others
+	var pending []nodeEvent
+	emit := func(ne nodeEvent) error {
+		cursor[ne.node] = ne.e.Cursor()
+		ne.e.Resume = cursor.String()
+		return send(ne.e)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err = <-done:
+			return err
+		case ne := <-events:
+			switch {
+			case ne.e.Type == contract.EventsStart:
+				if started[ne.node] {
+					continue
+				}
+				started[ne.node] = true
+				cursor[ne.node] = ne.e.Cursor()
+				if left--; left > 0 {
+					continue
+				}
+				if err = send(contract.TaskEvent{Type: contract.EventsStart, Resume: cursor.String()}); err != nil {
+					return err
+				}
+				for _, p := range pending {
+					if err = emit(p); err != nil {
+						return err
+					}
+				}
+				pending = nil
+			case left > 0:
+				pending = append(pending, ne)
+			default:
+				if err = emit(ne); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Node streams events of this node applied after from until ctx is done, it
+// serves the streams of the cluster.
+func (h EventsHandler) Node(
+	ctx context.Context,
+	condition *contract.Condition,
+	from contract.EventCursor,
+	send func(e contract.TaskEvent) error,
+) (err error) {
+	if send == nil {
+		return errors.New("nil send")
+	}
+	if err = h.policy.Allow(ctx, access.EVENTS); err != nil {
+		return err
+	}
+
+	return h.local(ctx, condition, from, send)
+}
+
+// local starts with an EventsStart event at the cursor the events follow.
+func (h EventsHandler) local(
+	ctx context.Context,
+	condition *contract.Condition,
+	from contract.EventCursor,
+	send func(e contract.TaskEvent) error,
+) (err error) {
+	backlog, ch, at, gap, cancel := h.db.Subscribe(condition, from)
+	defer cancel()
+
+	if err = send(contract.TaskEvent{Index: at.Index, Offset: at.Offset, Type: contract.EventsStart}); err != nil {
+		return err
+	}
+	if gap {
+		if err = send(contract.TaskEvent{Index: from.Index, Offset: from.Offset, Type: contract.EventsReset}); err != nil {
+			return err
+		}
+	}
+	for _, e := range backlog {
+		if err = send(e); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-ch:
+			if !ok {
+				return contract.ErrSlowSubscriber
+			}
+			if err = send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type eventsDb struct {
+	at contract.EventCursor
+	ch chan contract.TaskEvent
+}
+
+func (d eventsDb) Subscribe(
+	condition *contract.Condition,
+	from contract.EventCursor,
+) ([]contract.TaskEvent, <-chan contract.TaskEvent, contract.EventCursor, bool, func()) {
+	return nil, d.ch, d.at, false, func() {}
+}
+
+type eventsCluster struct {
+	events []contract.TaskEvent
+	err    error
+}
+
+func (c eventsCluster) Events(
+	ctx context.Context,
+	url string,
+	condition *contract.Condition,
+	from contract.EventCursor,
+	send func(e contract.TaskEvent) error,
+) error {
+	for _, e := range c.events {
+		if err := send(e); err != nil {
+			return err
+		}
+	}
+	if c.err != nil {
+		return c.err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestEventsHandler_Handle(t *testing.T) {
+	db := eventsDb{at: contract.EventCursor{Index: 3, Offset: math.MaxInt}, ch: make(chan contract.TaskEvent, 1)}
+	db.ch <- contract.TaskEvent{Index: 4, Type: contract.TaskPut}
+	cluster := eventsCluster{events: []contract.TaskEvent{
+		{Index: 5, Offset: math.MaxInt, Type: contract.EventsStart},
+		{Index: 6, Offset: 1, Type: contract.TaskPut},
+	}}
+	h, err := NewEventsHandler(db, cluster, "a", []string{"a", "b"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from, err := h.Cursor("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := errors.New("stop")
+	var got []contract.TaskEvent
+	err = h.Handle(context.Background(), nil, from, func(e contract.TaskEvent) error {
+		if got = append(got, e); len(got) == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("not correct end of stream %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("not correct events %v", got)
+	}
+	if got[0].Type != contract.EventsStart || got[0].Resume != "3,5" {
+		t.Errorf("not correct start %v", got[0])
+	}
+	if got[2].Resume != "4.0,6.1" {
+		t.Errorf("not correct resume cursor %v", got[2].Resume)
+	}
+
+	resume, err := h.Cursor(got[2].Resume)
+	if err != nil || resume[0] != (contract.EventCursor{Index: 4}) || resume[1] != (contract.EventCursor{Index: 6, Offset: 1}) {
+		t.Errorf("not correct parsed cursor %v %v", resume, err)
+	}
+	if _, err = h.Cursor("4.0"); err == nil {
+		t.Errorf("not correct cursor of another cluster accepted")
+	}
+}
+
+func TestEventsHandler_HandleNodeError(t *testing.T) {
+	db := eventsDb{ch: make(chan contract.TaskEvent)}
+	cluster := eventsCluster{err: contract.ErrSlowSubscriber}
+	h, err := NewEventsHandler(db, cluster, "a", []string{"a", "b"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = h.Handle(context.Background(), nil, make(contract.StreamCursor, 2), func(e contract.TaskEvent) error {
+		return nil
+	})
+	if !errors.Is(err, contract.ErrSlowSubscriber) {
+		t.Errorf("not correct error of node %v", err)
+	}
+}
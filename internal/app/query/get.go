@@ -1,9 +1,11 @@
 package query
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 	"github.com/serialx/hashring"
 )
@@ -14,6 +16,7 @@ type GetDbAdapter interface {
 
 type GetClusterAdapter interface {
 	Get(
+		ctx context.Context,
 		url string,
 		group string,
 		id string,
@@ -25,6 +28,7 @@ type GetHandler struct {
 	cluster GetClusterAdapter
 	ring    *hashring.HashRing
 	curUrl  string
+	policy  *access.Policy
 }
 
 func NewGetHandler(
@@ -32,6 +36,7 @@ func NewGetHandler(
 	cluster GetClusterAdapter,
 	ring *hashring.HashRing,
 	url string,
+	policy *access.Policy,
 ) (h GetHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil GetDbAdapter")
@@ -46,10 +51,11 @@ func NewGetHandler(
 		return h, errors.New("url is empty")
 	}
 
-	return GetHandler{db: db, cluster: cluster, ring: ring, curUrl: url}, nil
+	return GetHandler{db: db, cluster: cluster, ring: ring, curUrl: url, policy: policy}, nil
 }
 
 func (h GetHandler) Handle(
+	ctx context.Context,
 	group string,
 	id string,
 ) (task *contract.Task, err error) {
@@ -59,6 +65,9 @@ func (h GetHandler) Handle(
 	if id == "" {
 		return task, errors.New("id is empty")
 	}
+	if err = h.policy.AllowTasks(ctx, access.GET, id); err != nil {
+		return task, err
+	}
 
 	node, exists := h.ring.GetNode(group)
 	if !exists {
@@ -68,6 +77,6 @@ func (h GetHandler) Handle(
 	if node == h.curUrl {
 		return h.db.Get(id)
 	} else {
-		return h.cluster.Get(node, group, id)
+		return h.cluster.Get(ctx, node, group, id)
 	}
 }
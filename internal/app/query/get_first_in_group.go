@@ -1,9 +1,11 @@
 package query
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
 	"github.com/serialx/hashring"
 )
 
@@ -12,7 +14,7 @@ type GetFirstInGroupDbAdapter interface {
 }
 
 type GetFirstInGroupClusterAdapter interface {
-	GetFirstInGroup(url string, group string) (id string, err error)
+	GetFirstInGroup(ctx context.Context, url string, group string) (id string, err error)
 }
 
 type GetFirstInGroupHandler struct {
@@ -20,6 +22,7 @@ type GetFirstInGroupHandler struct {
 	cluster GetFirstInGroupClusterAdapter
 	ring    *hashring.HashRing
 	curUrl  string
+	policy  *access.Policy
 }
 
 func NewGetFirstInGroupHandler(
@@ -27,6 +30,7 @@ func NewGetFirstInGroupHandler(
 	cluster GetFirstInGroupClusterAdapter,
 	ring *hashring.HashRing,
 	url string,
+	policy *access.Policy,
 ) (h GetFirstInGroupHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil GetFirstInGroupDbAdapter")
@@ -46,10 +50,11 @@ func NewGetFirstInGroupHandler(
 		cluster: cluster,
 		ring:    ring,
 		curUrl:  url,
+		policy:  policy,
 	}, nil
 }
 
-func (h GetFirstInGroupHandler) Handle(group string) (id string, err error) {
+func (h GetFirstInGroupHandler) Handle(ctx context.Context, group string) (id string, err error) {
 	if group == "" {
 		return id, errors.New("group is empty")
 	}
@@ -60,8 +65,16 @@ func (h GetFirstInGroupHandler) Handle(group string) (id string, err error) {
 	}
 
 	if node == h.curUrl {
-		return h.db.GetFirstInGroup(group)
+		id, err = h.db.GetFirstInGroup(group)
 	} else {
-		return h.cluster.GetFirstInGroup(node, group)
+		id, err = h.cluster.GetFirstInGroup(ctx, node, group)
 	}
+	if err != nil || id == "" {
+		return id, err
+	}
+	// the kind is known by the id only
+	if err = h.policy.AllowTasks(ctx, access.GET, id); err != nil {
+		return "", err
+	}
+	return id, nil
 }
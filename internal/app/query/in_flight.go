@@ -0,0 +1,87 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+)
+
+type InFlightDbAdapter interface {
+	InFlight(ctx context.Context, kind string) (n int, err error)
+}
+
+type InFlightClusterAdapter interface {
+	InFlight(ctx context.Context, url string, kind string) (n int, err error)
+}
+
+type InFlightHandler struct {
+	db      InFlightDbAdapter
+	cluster InFlightClusterAdapter
+	curUrl  string
+	nodes   []string
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewInFlightHandler(
+	db InFlightDbAdapter,
+	cluster InFlightClusterAdapter,
+	url string,
+	nodes []string,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h InFlightHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil InFlightDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil InFlightClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return InFlightHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle counts the SCHEDULED tasks of kind in the cluster.
+func (h InFlightHandler) Handle(ctx context.Context, kind string, internal bool) (n int, err error) {
+	if kind == "" {
+		return n, errors.New("kind is empty")
+	}
+	if err = h.policy.Allow(ctx, access.KIND_LIST, kind); err != nil {
+		return n, err
+	}
+
+	if internal {
+		return h.db.InFlight(ctx, kind)
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (int, error) {
+			if node == h.curUrl {
+				return h.db.InFlight(ctx, kind)
+			}
+			return h.cluster.InFlight(ctx, node, kind)
+		},
+	)
+	if err = fanout.FirstError(results); err != nil {
+		return n, err
+	}
+	for _, r := range results {
+		n += r.Value
+	}
+	return n, nil
+}
@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type KindConfigsDbAdapter interface {
+	KindConfigs() (configs []contract.KindConfig, err error)
+}
+
+type KindConfigsHandler struct {
+	db     KindConfigsDbAdapter
+	policy *access.Policy
+}
+
+func NewKindConfigsHandler(db KindConfigsDbAdapter, policy *access.Policy) (h KindConfigsHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil KindConfigsDbAdapter")
+	}
+
+	return KindConfigsHandler{db: db, policy: policy}, nil
+}
+
+// Handle lists configured kinds of the node, a config is sent to every node.
+func (h KindConfigsHandler) Handle(ctx context.Context) (configs []contract.KindConfig, err error) {
+	if err = h.policy.Allow(ctx, access.KIND_LIST); err != nil {
+		return nil, err
+	}
+	return h.db.KindConfigs()
+}
@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type KindOwnersDbAdapter interface {
+	KindOwners() (kinds []contract.KindOwners)
+}
+
+type KindOwnersHandler struct {
+	db     KindOwnersDbAdapter
+	policy *access.Policy
+}
+
+func NewKindOwnersHandler(db KindOwnersDbAdapter, policy *access.Policy) (h KindOwnersHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil KindOwnersDbAdapter")
+	}
+
+	return KindOwnersHandler{db: db, policy: policy}, nil
+}
+
+// Handle lists the owners new tasks of every kind are assigned to round robin.
+func (h KindOwnersHandler) Handle(ctx context.Context) (kinds []contract.KindOwners, err error) {
+	if err = h.policy.Allow(ctx, access.KIND_LIST); err != nil {
+		return nil, err
+	}
+	return h.db.KindOwners(), nil
+}
@@ -0,0 +1,127 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type NamespacesDbAdapter interface {
+	Namespaces() (namespaces []contract.Namespace)
+	NamespaceRemoteUsage(name string, usage contract.NamespaceUsage)
+}
+
+type NamespacesClusterAdapter interface {
+	Namespaces(ctx context.Context, url string) (namespaces []contract.Namespace, err error)
+}
+
+type NamespacesHandler struct {
+	db      NamespacesDbAdapter
+	cluster NamespacesClusterAdapter
+	curUrl  string
+	nodes   []string
+	fanout  fanout.Executor
+	policy  *access.Policy
+}
+
+func NewNamespacesHandler(
+	db NamespacesDbAdapter,
+	cluster NamespacesClusterAdapter,
+	url string,
+	nodes []string,
+	fan fanout.Executor,
+	policy *access.Policy,
+) (h NamespacesHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil NamespacesDbAdapter")
+	}
+	if cluster == nil {
+		return h, errors.New("nil NamespacesClusterAdapter")
+	}
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return NamespacesHandler{
+		db:      db,
+		cluster: cluster,
+		curUrl:  url,
+		nodes:   nodes,
+		fanout:  fan,
+		policy:  policy,
+	}, nil
+}
+
+// Handle lists the namespaces with their usage in the cluster, internal
+// with the usage of the node.
+func (h NamespacesHandler) Handle(ctx context.Context, internal bool) (namespaces []contract.Namespace, err error) {
+	if err = h.policy.Allow(ctx, access.NAMESPACE); err != nil {
+		return nil, err
+	}
+
+	namespaces = h.db.Namespaces()
+	if internal {
+		return namespaces, nil
+	}
+	usage, err := h.usage(ctx, h.nodes)
+	if err != nil {
+		return nil, err
+	}
+	for i, ns := range namespaces {
+		namespaces[i].Usage = usage[ns.Name]
+	}
+	return namespaces, nil
+}
+
+// Refresh keeps the usage of the other nodes the quotas of this node are checked with.
+func (h NamespacesHandler) Refresh(ctx context.Context) error {
+	namespaces := h.db.Namespaces()
+	if len(namespaces) == 0 {
+		return nil
+	}
+	var others []string
+	for _, node := range h.nodes {
+		if node != h.curUrl {
+			others = append(others, node)
+		}
+	}
+	usage, err := h.usage(ctx, others)
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaces {
+		h.db.NamespaceRemoteUsage(ns.Name, usage[ns.Name])
+	}
+	return nil
+}
+
+// usage sums the usage of every namespace on nodes.
+func (h NamespacesHandler) usage(ctx context.Context, nodes []string) (map[string]contract.NamespaceUsage, error) {
+	results := fanout.Run(ctx, h.fanout, nodes,
+		func(ctx context.Context, node string) ([]contract.Namespace, error) {
+			if node == h.curUrl {
+				return h.db.Namespaces(), nil
+			}
+			return h.cluster.Namespaces(ctx, node)
+		},
+	)
+	if err := fanout.FirstError(results); err != nil {
+		return nil, err
+	}
+	usage := make(map[string]contract.NamespaceUsage)
+	for _, r := range results {
+		for _, ns := range r.Value {
+			u := usage[ns.Name]
+			u.Tasks += ns.Usage.Tasks
+			u.Bytes += ns.Usage.Bytes
+			usage[ns.Name] = u
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type OwnersDbAdapter interface {
+	Owners() (owners []contract.OwnerKinds, err error)
+}
+
+type OwnersHandler struct {
+	db     OwnersDbAdapter
+	policy *access.Policy
+}
+
+func NewOwnersHandler(db OwnersDbAdapter, policy *access.Policy) (h OwnersHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil OwnersDbAdapter")
+	}
+
+	return OwnersHandler{db: db, policy: policy}, nil
+}
+
+// Handle lists owners registered on the node, registration is sent to every node.
+func (h OwnersHandler) Handle(ctx context.Context) (owners []contract.OwnerKinds, err error) {
+	if err = h.policy.Allow(ctx, access.OWNER_LIST); err != nil {
+		return nil, err
+	}
+	return h.db.Owners()
+}
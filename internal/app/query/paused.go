@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type PausedDbAdapter interface {
+	Paused() (pauses []contract.Pause, err error)
+}
+
+type PausedHandler struct {
+	db     PausedDbAdapter
+	policy *access.Policy
+}
+
+func NewPausedHandler(db PausedDbAdapter, policy *access.Policy) (h PausedHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil PausedDbAdapter")
+	}
+
+	return PausedHandler{db: db, policy: policy}, nil
+}
+
+// Handle lists paused kinds and groups of the node, a pause is sent to every node.
+func (h PausedHandler) Handle(ctx context.Context) (pauses []contract.Pause, err error) {
+	if err = h.policy.Allow(ctx, access.KIND_LIST); err != nil {
+		return nil, err
+	}
+	return h.db.Paused()
+}
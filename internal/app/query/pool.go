@@ -1,39 +1,83 @@
 package query
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sort"
+	"time"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 	"github.com/serialx/hashring"
 )
 
 const (
 	size uint = 1000
+	// tasks without an owner claimed at once, the other owners get a share
+	claimSize uint = 10
 )
 
 type PoolDbAdapter interface {
 	Pool(
+		ctx context.Context,
 		owner string,
 		kind string,
 		size uint,
 	) (tasks []contract.Task, err error)
+
+	Watch(kind string) <-chan struct{}
+
+	KindConfig(kind string) (config contract.KindConfig, err error)
 }
 
 type PoolClusterAdapter interface {
 	Pool(
+		ctx context.Context,
+		url string,
+		owner string,
+		kind string,
+		wait time.Duration,
+	) (tasks []contract.Task, err error)
+
+	PoolLease(
+		ctx context.Context,
 		url string,
 		owner string,
 		kind string,
+		wait time.Duration,
 	) (tasks []contract.Task, err error)
 }
 
+// PoolInFlight counts the SCHEDULED tasks of a kind in the cluster.
+type PoolInFlight interface {
+	Handle(ctx context.Context, kind string, internal bool) (n int, err error)
+}
+
+// PoolLeaser marks the tasks handed out by a limited pool SCHEDULED.
+type PoolLeaser interface {
+	Handle(ctx context.Context, tasks []contract.UpdateRequest) (items []contract.BatchItem, err error)
+}
+
+// PoolClaimer gives an owner the tasks of the node without an owner and,
+// with steal, VIRGIN tasks of the busiest owner.
+type PoolClaimer interface {
+	Handle(ctx context.Context, owner string, kind string, size uint, steal bool) (tasks []contract.Task, err error)
+}
+
 type PoolHandler struct {
-	db      PoolDbAdapter
-	cluster PoolClusterAdapter
-	ring    *hashring.HashRing
-	curUrl  string
-	nodes   []string
+	db       PoolDbAdapter
+	cluster  PoolClusterAdapter
+	ring     *hashring.HashRing
+	curUrl   string
+	nodes    []string
+	fanout   fanout.Executor
+	inFlight PoolInFlight
+	leaser   PoolLeaser
+	claimer  PoolClaimer
+	limits   *kindLimits
+	policy   *access.Policy
 }
 
 func NewPoolHandler(
@@ -42,6 +86,11 @@ func NewPoolHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
+	fan fanout.Executor,
+	inFlight PoolInFlight,
+	leaser PoolLeaser,
+	claimer PoolClaimer,
+	policy *access.Policy,
 ) (h PoolHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil poolDbAdapter")
@@ -58,49 +107,198 @@ func NewPoolHandler(
 	if len(nodes) == 0 {
 		return h, errors.New("nodes is empty")
 	}
+	if inFlight == nil {
+		return h, errors.New("nil PoolInFlight")
+	}
+	if leaser == nil {
+		return h, errors.New("nil PoolLeaser")
+	}
+	if claimer == nil {
+		return h, errors.New("nil PoolClaimer")
+	}
 
 	return PoolHandler{
-		db:      db,
-		cluster: cluster,
-		ring:    ring,
-		curUrl:  url,
-		nodes:   nodes,
+		db:       db,
+		cluster:  cluster,
+		ring:     ring,
+		curUrl:   url,
+		nodes:    nodes,
+		fanout:   fan,
+		inFlight: inFlight,
+		leaser:   leaser,
+		claimer:  claimer,
+		limits:   newKindLimits(),
+		policy:   policy,
 	}, nil
 }
 
 func (h PoolHandler) Handle(
+	ctx context.Context,
 	owner string,
 	kind string,
 	internal bool,
-) (tasks []contract.Task, err error) {
+	allowPartial bool,
+	wait time.Duration,
+) (tasks []contract.Task, nodes []contract.NodeStatus, err error) {
 	if owner == "" {
-		return tasks, errors.New("owner is empty")
+		return tasks, nodes, errors.New("owner is empty")
 	}
 	if kind == "" {
-		return tasks, errors.New("kind is empty")
+		return tasks, nodes, errors.New("kind is empty")
+	}
+	if err = h.policy.Allow(ctx, access.POOL, kind); err != nil {
+		return tasks, nodes, err
 	}
 
 	if internal {
-		return h.db.Pool(owner, kind, size)
+		tasks, err = h.poolWait(ctx, owner, kind, clampWait(ctx, wait))
+		return tasks, nodes, err
+	}
+
+	config, err := h.db.KindConfig(kind)
+	if err != nil {
+		return tasks, nodes, err
+	}
+	// stolen tasks have to be unstarted, a leased task is SCHEDULED once handed out
+	if limited(config) || config.Steal {
+		node, exists := h.ring.GetNode(kind)
+		if !exists {
+			return tasks, nodes, fmt.Errorf("not found node by kind: %v", kind)
+		}
+		if node != h.curUrl {
+			tasks, err = h.cluster.PoolLease(ctx, node, owner, kind, wait)
+			return tasks, nodes, err
+		}
+		return h.Lease(ctx, owner, kind, allowPartial, wait)
 	}
 
-	var portion []contract.Task
+	tasks, nodes, err = h.fanoutPool(ctx, owner, kind, allowPartial, 0)
+	if err != nil || len(tasks) > 0 || wait <= 0 {
+		return tasks, nodes, err
+	}
 
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			portion, err = h.db.Pool(owner, kind, size)
-		} else {
-			portion, err = h.cluster.Pool(node, owner, kind)
+	nodeWait := min(clampWait(ctx, wait), h.fanout.Timeout()*9/10)
+	return h.fanoutPool(ctx, owner, kind, allowPartial, nodeWait)
+}
+
+func (h PoolHandler) fanoutPool(
+	ctx context.Context,
+	owner string,
+	kind string,
+	allowPartial bool,
+	wait time.Duration,
+) (tasks []contract.Task, nodes []contract.NodeStatus, err error) {
+	// while waiting the first node with tasks releases the others
+	waitCtx, wake := context.WithCancel(ctx)
+	defer wake()
+	woken := func() bool {
+		return waitCtx.Err() != nil && ctx.Err() == nil
+	}
+
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(nodeCtx context.Context, node string) (portion []contract.Task, err error) {
+			callCtx, cancel := context.WithCancel(nodeCtx)
+			defer cancel()
+			stop := context.AfterFunc(waitCtx, cancel)
+			defer stop()
+
+			if node == h.curUrl {
+				portion, err = h.poolWait(callCtx, owner, kind, wait)
+			} else {
+				portion, err = h.cluster.Pool(callCtx, node, owner, kind, wait)
+			}
+			if err != nil && woken() {
+				return nil, nil
+			}
+			if wait > 0 && len(portion) > 0 {
+				wake()
+			}
+			return portion, err
+		},
+	)
+
+	nodes = fanout.Statuses(results)
+	if allowPartial {
+		err = fanout.Partial(results)
+	} else {
+		err = fanout.FirstError(results)
+	}
+	if err != nil {
+		return nil, nodes, err
+	}
+	for _, r := range results {
+		tasks = append(tasks, r.Value...)
+	}
+
+	sort.SliceStable(tasks, func(i int, j int) bool {
+		return tasks[i].Id < tasks[j].Id
+	})
+
+	return tasks, nodes, nil
+}
+
+// poolWait holds the pool until a task of kind is applied or wait expires.
+func (h PoolHandler) poolWait(
+	ctx context.Context,
+	owner string,
+	kind string,
+	wait time.Duration,
+) (tasks []contract.Task, err error) {
+	if wait <= 0 {
+		return h.pool(ctx, owner, kind)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		// watch before reading, a task applied in between still wakes us
+		changed := h.db.Watch(kind)
+		tasks, err = h.pool(ctx, owner, kind)
+		if err != nil || len(tasks) > 0 {
+			return tasks, err
 		}
-		if err != nil {
-			return nil, err
+		select {
+		case <-changed:
+		case <-timer.C:
+			return tasks, nil
+		case <-ctx.Done():
+			return tasks, nil
 		}
-		tasks = append(tasks, portion...)
 	}
+}
 
+// pool reads the tasks of owner on the node. Tasks without an owner are claimed
+// for an UNASSIGNED kind or an idle owner, an idle owner of a steal kind also
+// takes tasks of the busiest owner.
+func (h PoolHandler) pool(ctx context.Context, owner string, kind string) (tasks []contract.Task, err error) {
+	tasks, err = h.db.Pool(ctx, owner, kind, size)
+	if err != nil || uint(len(tasks)) >= claimSize {
+		return tasks, err
+	}
+	config, err := h.db.KindConfig(kind)
+	if err != nil {
+		return nil, err
+	}
+	idle := len(tasks) == 0
+	if !idle && config.Strategy != contract.UNASSIGNED {
+		return tasks, nil
+	}
+
+	claimed, err := h.claimer.Handle(ctx, owner, kind, claimSize-uint(len(tasks)), config.Steal && idle)
+	if err != nil {
+		return nil, err
+	}
+	tasks = append(tasks, claimed...)
 	sort.SliceStable(tasks, func(i int, j int) bool {
 		return tasks[i].Id < tasks[j].Id
 	})
+	return tasks, nil
+}
 
-	return tasks, err
+// clampWait keeps the wait inside the request deadline so that an empty pool is not a timeout.
+func clampWait(ctx context.Context, wait time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		wait = min(wait, time.Until(deadline)*9/10)
+	}
+	return max(wait, 0)
 }
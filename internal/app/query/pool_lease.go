@@ -0,0 +1,144 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+// kindLimits serializes the leases of every limited kind on its
+// coordinator node, the ring node of the kind, and keeps its rate.
+type kindLimits struct {
+	mu    sync.Mutex
+	kinds map[string]*kindLimit
+}
+
+type kindLimit struct {
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newKindLimits() *kindLimits {
+	return &kindLimits{kinds: make(map[string]*kindLimit)}
+}
+
+func (l *kindLimits) get(kind string) *kindLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	k, ok := l.kinds[kind]
+	if !ok {
+		k = &kindLimit{}
+		l.kinds[kind] = k
+	}
+	return k
+}
+
+// take returns up to n tokens of a bucket filled with rate per second,
+// the bucket holds a second of tokens and at least one.
+func (k *kindLimit) take(rate float64, n int, now time.Time) int {
+	burst := max(rate, 1)
+	if k.last.IsZero() {
+		k.tokens = burst
+	} else {
+		k.tokens = min(burst, k.tokens+now.Sub(k.last).Seconds()*rate)
+	}
+	k.last = now
+	n = min(n, int(k.tokens))
+	k.tokens -= float64(n)
+	return n
+}
+
+func limited(config contract.KindConfig) bool {
+	return config.MaxInFlight > 0 || config.Rate > 0
+}
+
+// Lease hands out the tasks of a limited kind, it runs on the coordinator node.
+// New tasks are marked SCHEDULED within the limits of the kind, the tasks
+// already scheduled for the owner are returned again until reported.
+func (h PoolHandler) Lease(
+	ctx context.Context,
+	owner string,
+	kind string,
+	allowPartial bool,
+	wait time.Duration,
+) (tasks []contract.Task, nodes []contract.NodeStatus, err error) {
+	config, err := h.db.KindConfig(kind)
+	if err != nil {
+		return tasks, nodes, err
+	}
+
+	tasks, nodes, err = h.fanoutPool(ctx, owner, kind, allowPartial, 0)
+	if err == nil && len(tasks) == 0 && wait > 0 {
+		nodeWait := min(clampWait(ctx, wait), h.fanout.Timeout()*9/10)
+		tasks, nodes, err = h.fanoutPool(ctx, owner, kind, allowPartial, nodeWait)
+	}
+	if err != nil || len(tasks) == 0 {
+		return tasks, nodes, err
+	}
+
+	limit := h.limits.get(kind)
+	limit.mu.Lock()
+	defer limit.mu.Unlock()
+
+	// read again under the lock, a concurrent pool of the owner may have leased them
+	tasks, nodes, err = h.fanoutPool(ctx, owner, kind, allowPartial, 0)
+	if err != nil {
+		return tasks, nodes, err
+	}
+
+	leased := make([]contract.Task, 0, len(tasks))
+	virgin := make([]contract.Task, 0, len(tasks))
+	for _, t := range tasks {
+		switch t.Status {
+		case contract.SCHEDULED:
+			leased = append(leased, t)
+		case contract.VIRGIN:
+			virgin = append(virgin, t)
+		}
+	}
+
+	budget := min(len(virgin), int(size))
+	if config.MaxInFlight > 0 && budget > 0 {
+		n, err := h.inFlight.Handle(access.Internal(ctx), kind, false)
+		if err != nil {
+			return nil, nodes, err
+		}
+		budget = min(budget, max(config.MaxInFlight-n, 0))
+	}
+	if config.Rate > 0 && budget > 0 {
+		budget = limit.take(config.Rate, budget, time.Now())
+	}
+
+	if budget > 0 {
+		virgin = virgin[:budget]
+		updates := make([]contract.UpdateRequest, 0, budget)
+		for _, t := range virgin {
+			updates = append(updates, contract.UpdateRequest{
+				Id:     t.Id,
+				Group:  t.Group,
+				Status: int(contract.SCHEDULED),
+				Param:  t.Param,
+			})
+		}
+		items, err := h.leaser.Handle(access.Internal(ctx), updates)
+		if err != nil {
+			return nil, nodes, err
+		}
+		for i, item := range items {
+			if item.Error == nil {
+				virgin[i].Status = contract.SCHEDULED
+				leased = append(leased, virgin[i])
+			}
+		}
+	}
+
+	sort.SliceStable(leased, func(i int, j int) bool {
+		return leased[i].Id < leased[j].Id
+	})
+	return leased, nodes, nil
+}
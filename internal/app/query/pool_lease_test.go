@@ -0,0 +1,35 @@
+package query
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKindLimit_Take(t *testing.T) {
+	now := time.Now()
+	k := &kindLimit{}
+
+	if n := k.take(2, 5, now); n != 2 {
+		t.Errorf("not correct first take %d", n)
+	}
+	if n := k.take(2, 5, now); n != 0 {
+		t.Errorf("not correct take of empty bucket %d", n)
+	}
+	if n := k.take(2, 5, now.Add(500*time.Millisecond)); n != 1 {
+		t.Errorf("not correct take after refill %d", n)
+	}
+	if n := k.take(2, 5, now.Add(time.Hour)); n != 2 {
+		t.Errorf("not correct take of full bucket %d", n)
+	}
+
+	slow := &kindLimit{}
+	if n := slow.take(0.5, 5, now); n != 1 {
+		t.Errorf("not correct take below one per second %d", n)
+	}
+	if n := slow.take(0.5, 5, now.Add(time.Second)); n != 0 {
+		t.Errorf("not correct take before a token %d", n)
+	}
+	if n := slow.take(0.5, 5, now.Add(2*time.Second)); n != 1 {
+		t.Errorf("not correct take after a token %d", n)
+	}
+}
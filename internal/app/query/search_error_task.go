@@ -1,27 +1,41 @@
 package query
 
 import (
+	"context"
 	"errors"
 
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/app/fanout"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
 	"github.com/serialx/hashring"
 )
 
 type SearchErrorTaskDbAdapter interface {
 	SearchErrorTask(
+		ctx context.Context,
 		condition *contract.Condition,
 		kind *string,
 		size *uint,
 	) (tasks []contract.Task, err error)
+
+	SearchErrorTaskChunks(
+		ctx context.Context,
+		condition *contract.Condition,
+		kind *string,
+		size *uint,
+		send func(tasks []contract.Task) error,
+	) (err error)
 }
 
 type SearchErrorTaskClusterAdapter interface {
-	SearchErrorTask(
+	SearchErrorTaskChunks(
+		ctx context.Context,
 		url string,
 		condition *contract.Condition,
 		kind *string,
 		size *uint,
-	) (tasks []contract.Task, err error)
+		send func(tasks []contract.Task) error,
+	) (err error)
 }
 
 type SearchErrorTaskHandler struct {
@@ -30,6 +44,8 @@ type SearchErrorTaskHandler struct {
 	ring    *hashring.HashRing
 	curUrl  string
 	nodes   []string
+	fanout  fanout.Executor
+	policy  *access.Policy
 }
 
 func NewSearchErrorTaskHandler(
@@ -38,6 +54,8 @@ func NewSearchErrorTaskHandler(
 	ring *hashring.HashRing,
 	url string,
 	nodes []string,
+	fan fanout.Executor,
+	policy *access.Policy,
 ) (h SearchErrorTaskHandler, err error) {
 	if db == nil {
 		return h, errors.New("nil SearchErrorTaskDbAdapter")
@@ -61,35 +79,71 @@ func NewSearchErrorTaskHandler(
 		ring:    ring,
 		curUrl:  url,
 		nodes:   nodes,
+		fanout:  fan,
+		policy:  policy,
 	}, nil
 }
 
 func (h SearchErrorTaskHandler) Handle(
+	ctx context.Context,
 	condition *contract.Condition,
 	kind *string,
 	size *uint,
 	internal bool,
-) (tasks []contract.Task, err error) {
+	allowPartial bool,
+) (tasks []contract.Task, nodes []contract.NodeStatus, err error) {
 	if condition != nil && len(condition.Operations) == 0 && len(condition.Conditions) == 0 {
-		return tasks, errors.New("condition is empty")
+		return tasks, nodes, errors.New("condition is empty")
+	}
+	if err = h.policy.Allow(ctx, access.SEARCH, access.Kind(kind)); err != nil {
+		return tasks, nodes, err
 	}
 
 	if internal {
-		return h.db.SearchErrorTask(condition, kind, size)
+		tasks, err = h.db.SearchErrorTask(ctx, condition, kind, size)
+		return tasks, nodes, err
 	}
 
-	var portion []contract.Task
-
-	for _, node := range h.nodes {
-		if node == h.curUrl {
-			portion, err = h.db.SearchErrorTask(condition, kind, size)
-		} else {
-			portion, err = h.cluster.SearchErrorTask(node, condition, kind, size)
+	// the chunks of the nodes are merged as they arrive
+	merge := fanout.NewMerge(size)
+	results := fanout.Run(ctx, h.fanout, h.nodes,
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == h.curUrl {
+				return struct{}{}, h.db.SearchErrorTaskChunks(ctx, condition, kind, size, merge.Add(node))
+			}
+			return struct{}{}, h.cluster.SearchErrorTaskChunks(ctx, node, condition, kind, size, merge.Add(node))
+		},
+	)
+	for _, r := range results {
+		if r.Err != nil {
+			merge.Drop(r.Node)
 		}
-		if err != nil {
-			return nil, err
-		}
-		tasks = append(tasks, portion...)
 	}
-	return tasks, err
+	nodes = fanout.Statuses(results)
+	if allowPartial {
+		err = fanout.Partial(results)
+	} else {
+		err = fanout.FirstError(results)
+	}
+	if err != nil {
+		return nil, nodes, err
+	}
+	return merge.Tasks(), nodes, nil
+}
+
+// Chunks hands the tasks of the node to send chunk by chunk while they are read.
+func (h SearchErrorTaskHandler) Chunks(
+	ctx context.Context,
+	condition *contract.Condition,
+	kind *string,
+	size *uint,
+	send func(tasks []contract.Task) error,
+) (err error) {
+	if condition != nil && len(condition.Operations) == 0 && len(condition.Conditions) == 0 {
+		return errors.New("condition is empty")
+	}
+	if err = h.policy.Allow(ctx, access.SEARCH, access.Kind(kind)); err != nil {
+		return err
+	}
+	return h.db.SearchErrorTaskChunks(ctx, condition, kind, size, send)
 }
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/hashicorp/raft"
+)
+
+type StatusHandler struct {
+	raft   *raft.Raft
+	curUrl string
+	nodes  []string
+	policy *access.Policy
+}
+
+func NewStatusHandler(
+	raft *raft.Raft,
+	url string,
+	nodes []string,
+	policy *access.Policy,
+) (h StatusHandler, err error) {
+	if url == "" {
+		return h, errors.New("url is empty")
+	}
+	if len(nodes) == 0 {
+		return h, errors.New("nodes is empty")
+	}
+
+	return StatusHandler{raft: raft, curUrl: url, nodes: nodes, policy: policy}, nil
+}
+
+// Handle reports the ring of the cluster and the raft state of the node.
+func (h StatusHandler) Handle(ctx context.Context) (res contract.StatusResponse, err error) {
+	if err = h.policy.Allow(ctx, access.STATUS); err != nil {
+		return res, err
+	}
+	res = contract.StatusResponse{Node: h.curUrl, Nodes: h.nodes}
+	if h.raft == nil {
+		return res, nil
+	}
+
+	stats := h.raft.Stats()
+	leader, _ := h.raft.LeaderWithID()
+	res.Raft = &contract.RaftStatus{
+		State:        h.raft.State().String(),
+		Leader:       string(leader),
+		Term:         statUint(stats["term"]),
+		LastIndex:    h.raft.LastIndex(),
+		AppliedIndex: h.raft.AppliedIndex(),
+		Peers:        int(statUint(stats["num_peers"])),
+	}
+	return res, nil
+}
+
+func statUint(v string) uint64 {
+	n, _ := strconv.ParseUint(v, 10, 64)
+	return n
+}
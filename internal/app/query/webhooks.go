@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+type WebhooksDbAdapter interface {
+	Webhooks() (webhooks []contract.Webhook, err error)
+}
+
+type WebhooksHandler struct {
+	db     WebhooksDbAdapter
+	policy *access.Policy
+}
+
+func NewWebhooksHandler(db WebhooksDbAdapter, policy *access.Policy) (h WebhooksHandler, err error) {
+	if db == nil {
+		return h, errors.New("nil WebhooksDbAdapter")
+	}
+
+	return WebhooksHandler{db: db, policy: policy}, nil
+}
+
+// Handle lists subscriptions of the node, every node keeps all of them.
+// Secrets are not returned.
+func (h WebhooksHandler) Handle(ctx context.Context) (webhooks []contract.Webhook, err error) {
+	if err = h.policy.Allow(ctx, access.WEBHOOK_LIST); err != nil {
+		return nil, err
+	}
+	webhooks, err = h.db.Webhooks()
+	if err != nil {
+		return nil, err
+	}
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+	return webhooks, nil
+}
@@ -1,10 +1,16 @@
 package config
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"net"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -17,12 +23,41 @@ type Config struct {
 		Servers     []string
 		Current     string
 		CurrentPort string
+		Timeout     time.Duration
+		Transport   string
+		GrpcPort    string
+		GrpcServers map[string]string
+	}
+
+	Http struct {
+		Timeout time.Duration
+	}
+
+	Grpc struct {
+		Port string
+	}
+
+	Auth struct {
+		Keys         []string
+		JwtSecret    string
+		ClusterToken string
+		Policy       string
+	}
+
+	Tls struct {
+		Cert string
+		Key  string
+		Ca   string
+		// every client of the public ports needs a certificate
+		RequireClient bool
 	}
 
 	Raft struct {
-		Path    string
-		Servers []RaftNode
-		Current *RaftNode
+		Path         string
+		Servers      []RaftNode
+		Current      *RaftNode
+		CommitWindow time.Duration
+		CommitSize   int
 	}
 }
 
@@ -38,10 +73,28 @@ func NewConfig(logger *log.Logger) (Config, error) {
 	сport := flag.String("cport", "", "http port")
 	сservers := flag.String("csrvs", "", "cluster servers")
 	caddr := flag.String("caddr", "", "curent cluster server")
+	ctimeout := flag.String("ctimeout", "", "cluster call timeout per node")
+	stimeout := flag.String("stimeout", "", "http request deadline")
+	ctransport := flag.String("ctransport", "", "cluster transport http or grpc")
+	cgport := flag.String("cgport", "", "cluster grpc port")
+	cgservers := flag.String("cgsrvs", "", "cluster grpc servers in the order of csrvs")
+	gport := flag.String("gport", "", "public grpc port")
 
 	rpath := flag.String("rpath", "", "path db")
 	rservers := flag.String("rsrvs", "", "cluster servers")
 	raddr := flag.String("raddr", "", "curent cluster server")
+	rcwindow := flag.String("rcwindow", "", "group commit wait for more commands per raft entry")
+	rcsize := flag.String("rcsize", "", "group commit max events per raft entry")
+
+	akeys := flag.String("akeys", "", "comma separated api keys as name:key")
+	ajwt := flag.String("ajwt", "", "secret of HMAC signed jwt bearer tokens")
+	actoken := flag.String("actoken", "", "token of calls between cluster nodes, required with api keys or jwt")
+	apolicy := flag.String("apolicy", "", "json file of the access policy")
+
+	tcert := flag.String("tcert", "", "tls certificate file of the node")
+	tkey := flag.String("tkey", "", "tls key file of the node")
+	tca := flag.String("tca", "", "tls ca file verifying nodes and clients")
+	tclient := flag.String("tclient", "", "require client certificates on public ports")
 
 	protocol := flag.String("protocol", "", "http or https or other")
 	flag.Parse()
@@ -77,9 +130,42 @@ func NewConfig(logger *log.Logger) (Config, error) {
 		}
 	}
 
+	if *tcert == "" {
+		*tcert = os.Getenv("TSB_TCERT")
+	}
+	if *tkey == "" {
+		*tkey = os.Getenv("TSB_TKEY")
+	}
+	if *tca == "" {
+		*tca = os.Getenv("TSB_TCA")
+	}
+	if (*tcert == "") != (*tkey == "") {
+		return config, errors.New("tls cert and key are required together")
+	}
+	if *tca != "" && *tcert == "" {
+		return config, errors.New("tls ca requires tls cert and key")
+	}
+	config.Tls.Cert, config.Tls.Key, config.Tls.Ca = *tcert, *tkey, *tca
+	if *tclient == "" {
+		*tclient = os.Getenv("TSB_TCLIENT")
+	}
+	if *tclient != "" {
+		config.Tls.RequireClient, err = strconv.ParseBool(*tclient)
+		if err != nil {
+			return config, fmt.Errorf("could not parse tclient: %v", err)
+		}
+		if config.Tls.RequireClient && *tca == "" {
+			return config, errors.New("client certificates require tls ca")
+		}
+	}
+
 	if *protocol == "" {
-		logger.Println("Protocol not specified, use default protocol http")
-		*protocol = "http"
+		if *tcert != "" {
+			*protocol = "https"
+		} else {
+			logger.Println("Protocol not specified, use default protocol http")
+			*protocol = "http"
+		}
 	}
 
 	if *caddr == "" {
@@ -100,6 +186,83 @@ func NewConfig(logger *log.Logger) (Config, error) {
 	}
 	config.Cluster.Servers = strings.Split(*сservers, ",")
 
+	if *ctimeout == "" {
+		if *ctimeout = os.Getenv("TSB_CTIMEOUT"); *ctimeout == "" {
+			*ctimeout = "10s"
+		}
+	}
+	config.Cluster.Timeout, err = time.ParseDuration(*ctimeout)
+	if err != nil {
+		return config, err
+	}
+
+	if *ctransport == "" {
+		if *ctransport = os.Getenv("TSB_CTRANSPORT"); *ctransport == "" {
+			*ctransport = "http"
+		}
+	}
+	if *ctransport != "http" && *ctransport != "grpc" {
+		return config, fmt.Errorf("unknown cluster transport: %v", *ctransport)
+	}
+	config.Cluster.Transport = *ctransport
+
+	if *cgport == "" {
+		if *cgport = os.Getenv("TSB_CGPORT"); *cgport == "" {
+			*cgport = "9090"
+		}
+	}
+	config.Cluster.GrpcPort = *cgport
+
+	if *cgservers == "" {
+		*cgservers = os.Getenv("TSB_CGSRVS")
+	}
+	config.Cluster.GrpcServers, err = grpcServers(config.Cluster.Servers, *cgservers, *cgport)
+	if err != nil {
+		return config, err
+	}
+
+	if *gport == "" {
+		if *gport = os.Getenv("TSB_GPORT"); *gport == "" {
+			logger.Println("Grpc port not specified, public grpc api disabled")
+		}
+	}
+	config.Grpc.Port = *gport
+
+	if *stimeout == "" {
+		if *stimeout = os.Getenv("TSB_STIMEOUT"); *stimeout == "" {
+			*stimeout = "10s"
+		}
+	}
+	config.Http.Timeout, err = time.ParseDuration(*stimeout)
+	if err != nil {
+		return config, err
+	}
+
+	if *akeys == "" {
+		*akeys = os.Getenv("TSB_AKEYS")
+	}
+	if *akeys != "" {
+		config.Auth.Keys = strings.Split(*akeys, ",")
+	}
+	if *ajwt == "" {
+		*ajwt = os.Getenv("TSB_AJWT")
+	}
+	config.Auth.JwtSecret = *ajwt
+	if *actoken == "" {
+		*actoken = os.Getenv("TSB_ACTOKEN")
+	}
+	config.Auth.ClusterToken = *actoken
+	if (len(config.Auth.Keys) > 0 || config.Auth.JwtSecret != "") && config.Auth.ClusterToken == "" {
+		return config, errors.New("cluster token required when authentication is enabled")
+	}
+	if *apolicy == "" {
+		*apolicy = os.Getenv("TSB_APOLICY")
+	}
+	if *apolicy != "" && len(config.Auth.Keys) == 0 && config.Auth.JwtSecret == "" {
+		return config, errors.New("access policy requires api keys or jwt")
+	}
+	config.Auth.Policy = *apolicy
+
 	if *rpath == "" {
 		if *rpath = os.Getenv("TSB_RPATH"); *rpath == "" {
 			logger.Println("Path to raft not specified, use current directory")
@@ -113,6 +276,26 @@ func NewConfig(logger *log.Logger) (Config, error) {
 	}
 	config.Raft.Path = *rpath + "/" + host
 
+	if *rcwindow == "" {
+		if *rcwindow = os.Getenv("TSB_RCWINDOW"); *rcwindow == "" {
+			*rcwindow = "0s"
+		}
+	}
+	config.Raft.CommitWindow, err = time.ParseDuration(*rcwindow)
+	if err != nil {
+		return config, err
+	}
+
+	if *rcsize == "" {
+		*rcsize = os.Getenv("TSB_RCSIZE")
+	}
+	if *rcsize != "" {
+		config.Raft.CommitSize, err = strconv.Atoi(*rcsize)
+		if err != nil {
+			return config, fmt.Errorf("could not parse rcsize: %v", err)
+		}
+	}
+
 	if *raddr == "" {
 		*raddr = os.Getenv("TSB_RADDR")
 	}
@@ -144,3 +327,28 @@ func NewConfig(logger *log.Logger) (Config, error) {
 
 	return config, nil
 }
+
+// grpcServers maps every cluster url to its grpc address,
+// by default the host of the url with the cluster grpc port.
+func grpcServers(servers []string, grpcServers string, port string) (map[string]string, error) {
+	addrs := make(map[string]string, len(servers))
+	if grpcServers != "" {
+		it := strings.Split(grpcServers, ",")
+		if len(it) != len(servers) {
+			return nil, fmt.Errorf("cluster grpc servers count %d not equal cluster servers count %d", len(it), len(servers))
+		}
+		for i, server := range servers {
+			addrs[server] = it[i]
+		}
+		return addrs, nil
+	}
+
+	for _, server := range servers {
+		u, err := url.Parse(server)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse cluster server %v: %v", server, err)
+		}
+		addrs[server] = net.JoinHostPort(u.Hostname(), port)
+	}
+	return addrs, nil
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Certs holds the certificate of the node and the CA of its peers, both are
+// reloaded when their files change.
+type Certs struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	logger   *log.Logger
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func NewCerts(certFile string, keyFile string, caFile string, logger *log.Logger) (*Certs, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("tls cert and key are required")
+	}
+	if logger == nil {
+		return nil, errors.New("nil logger")
+	}
+	c := &Certs{certFile: certFile, keyFile: keyFile, caFile: caFile, logger: logger}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Watch reloads the files every interval until stop is called, a broken file
+// keeps the certificates loaded before.
+func (c *Certs) Watch(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if !c.changed() {
+					continue
+				}
+				if err := c.load(); err != nil {
+					c.logger.Printf("Could not reload tls certificates %+v\n", err)
+					// tried again when the files change again
+					c.mu.Lock()
+					c.modTime = c.lastModified()
+					c.mu.Unlock()
+					continue
+				}
+				c.logger.Println("Tls certificates reloaded")
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func (c *Certs) changed() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastModified().After(c.modTime)
+}
+
+func (c *Certs) lastModified() (last time.Time) {
+	for _, file := range []string{c.certFile, c.keyFile, c.caFile} {
+		if file == "" {
+			continue
+		}
+		if info, err := os.Stat(file); err == nil && info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last
+}
+
+func (c *Certs) load() error {
+	modTime := c.lastModified()
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return fmt.Errorf("load tls key pair error: %v", err)
+	}
+	var pool *x509.CertPool
+	if c.caFile != "" {
+		pem, err := os.ReadFile(c.caFile)
+		if err != nil {
+			return fmt.Errorf("read tls ca error: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate in tls ca %v", c.caFile)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cert, c.pool, c.modTime = &cert, pool, modTime
+	return nil
+}
+
+func (c *Certs) certificate() *tls.Certificate {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert
+}
+
+// roots is the CA of the peers, nil for the system roots.
+func (c *Certs) roots() *x509.CertPool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.pool
+}
+
+// ServerConfig verifies client certificates by the CA, when required every
+// client needs one, otherwise only a given one is verified.
+func (c *Certs) ServerConfig(requireClient bool) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return c.certificate(), nil
+		},
+	}
+	if c.caFile == "" {
+		return config
+	}
+	// verified by VerifyConnection, the CA changes on reload
+	config.ClientAuth = tls.RequestClientCert
+	if requireClient {
+		config.ClientAuth = tls.RequireAnyClientCert
+	}
+	config.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return nil
+		}
+		return c.verify(cs.PeerCertificates, "", x509.ExtKeyUsageClientAuth)
+	}
+	return config
+}
+
+// ClientConfig presents the node certificate and verifies the server by the
+// CA as loaded at the time of the handshake.
+func (c *Certs) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return c.certificate(), nil
+		},
+		// verified by VerifyConnection, the CA changes on reload
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("tls peer without certificate")
+			}
+			return c.verify(cs.PeerCertificates, cs.ServerName, x509.ExtKeyUsageServerAuth)
+		},
+	}
+}
+
+func (c *Certs) verify(chain []*x509.Certificate, name string, usage x509.ExtKeyUsage) error {
+	opts := x509.VerifyOptions{
+		DNSName:       name,
+		Roots:         c.roots(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	}
+	for _, cert := range chain[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(opts)
+	return err
+}
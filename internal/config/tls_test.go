@@ -0,0 +1,155 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCa struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCa(t *testing.T) testCa {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return testCa{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// writeNode writes a certificate of 127.0.0.1 named name signed by ca.
+func (ca testCa) writeNode(t *testing.T, dir string, name string) (certFile string, keyFile string) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+	certFile, keyFile = filepath.Join(dir, "node.crt"), filepath.Join(dir, "node.key")
+	os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600)
+	return certFile, keyFile
+}
+
+// handshake connects client to server and returns the common name of the server certificate.
+func handshake(server *tls.Config, client *tls.Config) (string, error) {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+	go func() {
+		if conn, err := l.Accept(); err == nil {
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	config := client.Clone()
+	config.ServerName = "127.0.0.1"
+	conn, err := tls.Dial("tcp", l.Addr().String(), config)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	// with tls 1.3 a rejected client certificate fails the first read
+	if _, err = conn.Read(make([]byte, 1)); err != nil && err != io.EOF {
+		return "", err
+	}
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+func TestCerts(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCa(t)
+	caFile := filepath.Join(dir, "ca.crt")
+	os.WriteFile(caFile, ca.pem, 0o600)
+	certFile, keyFile := ca.writeNode(t, dir, "node1")
+	logger := log.New(io.Discard, "", 0)
+
+	certs, err := NewCerts(certFile, keyFile, caFile, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, err := handshake(certs.ServerConfig(true), certs.ClientConfig()); err != nil || name != "node1" {
+		t.Errorf("not correct mutual tls %v %v", name, err)
+	}
+
+	other := newTestCa(t)
+	otherFile := filepath.Join(t.TempDir(), "other.crt")
+	os.WriteFile(otherFile, other.pem, 0o600)
+	otherCertFile, otherKeyFile := other.writeNode(t, t.TempDir(), "stranger")
+	stranger, err := NewCerts(otherCertFile, otherKeyFile, otherFile, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = handshake(certs.ServerConfig(true), stranger.ClientConfig()); err == nil {
+		t.Errorf("not correct server of other ca accepted")
+	}
+	plain := &tls.Config{RootCAs: x509.NewCertPool()}
+	plain.RootCAs.AddCert(ca.cert)
+	if _, err = handshake(certs.ServerConfig(true), plain); err == nil {
+		t.Errorf("not correct client without certificate accepted")
+	}
+	if _, err = handshake(certs.ServerConfig(false), plain); err != nil {
+		t.Errorf("not correct optional client certificate %v", err)
+	}
+
+	stop := certs.Watch(10 * time.Millisecond)
+	defer stop()
+	ca.writeNode(t, dir, "node2")
+	later := time.Now().Add(time.Second)
+	os.Chtimes(certFile, later, later)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		name, err := handshake(certs.ServerConfig(true), certs.ClientConfig())
+		if err == nil && name == "node2" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("not correct reload %v %v", name, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	os.WriteFile(certFile, []byte("broken"), 0o600)
+	os.Chtimes(certFile, later.Add(time.Second), later.Add(time.Second))
+	time.Sleep(50 * time.Millisecond)
+	if name, err := handshake(certs.ServerConfig(true), certs.ClientConfig()); err != nil || name != "node2" {
+		t.Errorf("not correct certificate kept after broken file %v %v", name, err)
+	}
+}
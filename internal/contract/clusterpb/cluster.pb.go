@@ -0,0 +1,2230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: cluster/v1/cluster.proto
+
+package clusterpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{0}
+}
+
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Owner         *string                `protobuf:"bytes,4,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,6,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ts            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	Error         *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Task) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Task) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Task) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+func (x *Task) GetTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ts
+	}
+	return nil
+}
+
+func (x *Task) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type TaskChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskChunk) Reset() {
+	*x = TaskChunk{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskChunk) ProtoMessage() {}
+
+func (x *TaskChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskChunk.ProtoReflect.Descriptor instead.
+func (*TaskChunk) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskChunk) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TaskUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          *string                `protobuf:"bytes,1,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Group         *string                `protobuf:"bytes,2,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Owner         *string                `protobuf:"bytes,3,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Status        *int32                 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,5,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskUpdate) Reset() {
+	*x = TaskUpdate{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUpdate) ProtoMessage() {}
+
+func (x *TaskUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUpdate.ProtoReflect.Descriptor instead.
+func (*TaskUpdate) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskUpdate) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *TaskUpdate) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type AddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Owner         *string                `protobuf:"bytes,3,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,4,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRequest) Reset() {
+	*x = AddRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRequest) ProtoMessage() {}
+
+func (x *AddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRequest.ProtoReflect.Descriptor instead.
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *AddRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddRequest) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *AddRequest) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+type AddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResponse) Reset() {
+	*x = AddResponse{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResponse) ProtoMessage() {}
+
+func (x *AddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResponse.ProtoReflect.Descriptor instead.
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,4,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type AddBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*AddRequest          `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBatchRequest) Reset() {
+	*x = AddBatchRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBatchRequest) ProtoMessage() {}
+
+func (x *AddBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBatchRequest.ProtoReflect.Descriptor instead.
+func (*AddBatchRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddBatchRequest) GetTasks() []*AddRequest {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type UpdateBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*UpdateRequest       `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBatchRequest) Reset() {
+	*x = UpdateBatchRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchRequest) ProtoMessage() {}
+
+func (x *UpdateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateBatchRequest) GetTasks() []*UpdateRequest {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type BatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchItem) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BatchItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchResponse) GetItems() []*BatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3,oneof" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type GetFirstInGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirstInGroupRequest) Reset() {
+	*x = GetFirstInGroupRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirstInGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirstInGroupRequest) ProtoMessage() {}
+
+func (x *GetFirstInGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirstInGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetFirstInGroupRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetFirstInGroupRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type GetFirstInGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirstInGroupResponse) Reset() {
+	*x = GetFirstInGroupResponse{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirstInGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirstInGroupResponse) ProtoMessage() {}
+
+func (x *GetFirstInGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirstInGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetFirstInGroupResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetFirstInGroupResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type OwnerRegRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Owner string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Kinds []string               `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// 0 never expires
+	TtlMs         int64 `protobuf:"varint,3,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	Weight        int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerRegRequest) Reset() {
+	*x = OwnerRegRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerRegRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerRegRequest) ProtoMessage() {}
+
+func (x *OwnerRegRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerRegRequest.ProtoReflect.Descriptor instead.
+func (*OwnerRegRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OwnerRegRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerRegRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *OwnerRegRequest) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+func (x *OwnerRegRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type OwnerHeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TtlMs         int64                  `protobuf:"varint,2,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerHeartbeatRequest) Reset() {
+	*x = OwnerHeartbeatRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerHeartbeatRequest) ProtoMessage() {}
+
+func (x *OwnerHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*OwnerHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OwnerHeartbeatRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerHeartbeatRequest) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+type OwnerUnRegRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Owner string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// empty unregisters every kind of the owner
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Handoff       bool   `protobuf:"varint,3,opt,name=handoff,proto3" json:"handoff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerUnRegRequest) Reset() {
+	*x = OwnerUnRegRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerUnRegRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerUnRegRequest) ProtoMessage() {}
+
+func (x *OwnerUnRegRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerUnRegRequest.ProtoReflect.Descriptor instead.
+func (*OwnerUnRegRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OwnerUnRegRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerUnRegRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OwnerUnRegRequest) GetHandoff() bool {
+	if x != nil {
+		return x.Handoff
+	}
+	return false
+}
+
+type PauseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// false resumes
+	Paused        bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRequest) Reset() {
+	*x = PauseRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRequest) ProtoMessage() {}
+
+func (x *PauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PauseRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PauseRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *PauseRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type KindConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Ordered       bool                   `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	MaxInFlight   int32                  `protobuf:"varint,3,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Strategy      string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	HashParam     string                 `protobuf:"bytes,6,opt,name=hash_param,json=hashParam,proto3" json:"hash_param,omitempty"`
+	Steal         bool                   `protobuf:"varint,7,opt,name=steal,proto3" json:"steal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindConfig) Reset() {
+	*x = KindConfig{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindConfig) ProtoMessage() {}
+
+func (x *KindConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindConfig.ProtoReflect.Descriptor instead.
+func (*KindConfig) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *KindConfig) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KindConfig) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *KindConfig) GetMaxInFlight() int32 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+func (x *KindConfig) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *KindConfig) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *KindConfig) GetHashParam() string {
+	if x != nil {
+		return x.HashParam
+	}
+	return ""
+}
+
+func (x *KindConfig) GetSteal() bool {
+	if x != nil {
+		return x.Steal
+	}
+	return false
+}
+
+type InFlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InFlightRequest) Reset() {
+	*x = InFlightRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightRequest) ProtoMessage() {}
+
+func (x *InFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InFlightRequest.ProtoReflect.Descriptor instead.
+func (*InFlightRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InFlightRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type InFlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InFlightResponse) Reset() {
+	*x = InFlightResponse{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InFlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightResponse) ProtoMessage() {}
+
+func (x *InFlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InFlightResponse.ProtoReflect.Descriptor instead.
+func (*InFlightResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InFlightResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PoolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Owner string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Kind  string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// long polling, hold the pool until a task is available
+	WaitMs int64 `protobuf:"varint,3,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+	// the node is the coordinator of a limited kind and leases tasks
+	Lease         bool `protobuf:"varint,4,opt,name=lease,proto3" json:"lease,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolRequest) Reset() {
+	*x = PoolRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolRequest) ProtoMessage() {}
+
+func (x *PoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolRequest.ProtoReflect.Descriptor instead.
+func (*PoolRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PoolRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PoolRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PoolRequest) GetWaitMs() int64 {
+	if x != nil {
+		return x.WaitMs
+	}
+	return 0
+}
+
+func (x *PoolRequest) GetLease() bool {
+	if x != nil {
+		return x.Lease
+	}
+	return false
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// json encoded contract.Condition, keeps the operand types of the http api
+	Condition     []byte  `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Kind          *string `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Size          *uint64 `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchRequest) GetCondition() []byte {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSize() uint64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+type SearchUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Up            *TaskUpdate            `protobuf:"bytes,1,opt,name=up,proto3" json:"up,omitempty"`
+	Condition     []byte                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	Kind          *string                `protobuf:"bytes,3,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Size          *uint64                `protobuf:"varint,4,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUpdateRequest) Reset() {
+	*x = SearchUpdateRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUpdateRequest) ProtoMessage() {}
+
+func (x *SearchUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SearchUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchUpdateRequest) GetUp() *TaskUpdate {
+	if x != nil {
+		return x.Up
+	}
+	return nil
+}
+
+func (x *SearchUpdateRequest) GetCondition() []byte {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *SearchUpdateRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *SearchUpdateRequest) GetSize() uint64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Kinds         []string               `protobuf:"bytes,3,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	Statuses      []int32                `protobuf:"varint,4,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	Secret        string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *Webhook) GetStatuses() []int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type WebhookUnRegRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookUnRegRequest) Reset() {
+	*x = WebhookUnRegRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookUnRegRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookUnRegRequest) ProtoMessage() {}
+
+func (x *WebhookUnRegRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookUnRegRequest.ProtoReflect.Descriptor instead.
+func (*WebhookUnRegRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WebhookUnRegRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Namespace struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxTasks int64                  `protobuf:"varint,2,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+	MaxBytes int64                  `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// usage of the node
+	Tasks         int64 `protobuf:"varint,4,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Bytes         int64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetMaxTasks() int64 {
+	if x != nil {
+		return x.MaxTasks
+	}
+	return 0
+}
+
+func (x *Namespace) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Namespace) GetTasks() int64 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *Namespace) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type NamespaceDropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceDropRequest) Reset() {
+	*x = NamespaceDropRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceDropRequest) ProtoMessage() {}
+
+func (x *NamespaceDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceDropRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceDropRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NamespaceDropRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NamespacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces    []*Namespace           `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespacesResponse) Reset() {
+	*x = NamespacesResponse{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacesResponse) ProtoMessage() {}
+
+func (x *NamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacesResponse.ProtoReflect.Descriptor instead.
+func (*NamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NamespacesResponse) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type EventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// json encoded contract.Condition
+	Condition []byte `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	// raft index and offset of the event to resume after, none without index
+	Index         uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Offset        int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest) ProtoMessage() {}
+
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EventsRequest) GetCondition() []byte {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *EventsRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EventsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint64                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Task          *Task                  `protobuf:"bytes,4,opt,name=task,proto3,oneof" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_cluster_v1_cluster_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_v1_cluster_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_cluster_v1_cluster_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TaskEvent) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+var File_cluster_v1_cluster_proto protoreflect.FileDescriptor
+
+const file_cluster_v1_cluster_proto_rawDesc = "" +
+	"\n" +
+	"\x18cluster/v1/cluster.proto\x12\x16taskstoredb.cluster.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
+	"\x05Empty\"\xc7\x02\n" +
+	"\x04Task\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\x12\x19\n" +
+	"\x05owner\x18\x04 \x01(\tH\x00R\x05owner\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12=\n" +
+	"\x05param\x18\x06 \x03(\v2'.taskstoredb.cluster.v1.Task.ParamEntryR\x05param\x12*\n" +
+	"\x02ts\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02ts\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x01R\x05error\x88\x01\x01\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_ownerB\b\n" +
+	"\x06_error\"?\n" +
+	"\tTaskChunk\x122\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x1c.taskstoredb.cluster.v1.TaskR\x05tasks\"\xc4\x02\n" +
+	"\n" +
+	"TaskUpdate\x12\x17\n" +
+	"\x04kind\x18\x01 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x19\n" +
+	"\x05group\x18\x02 \x01(\tH\x01R\x05group\x88\x01\x01\x12\x19\n" +
+	"\x05owner\x18\x03 \x01(\tH\x02R\x05owner\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\x05H\x03R\x06status\x88\x01\x01\x12C\n" +
+	"\x05param\x18\x05 \x03(\v2-.taskstoredb.cluster.v1.TaskUpdate.ParamEntryR\x05param\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x04R\x05error\x88\x01\x01\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_kindB\b\n" +
+	"\x06_groupB\b\n" +
+	"\x06_ownerB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_error\"\xda\x01\n" +
+	"\n" +
+	"AddRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x19\n" +
+	"\x05owner\x18\x03 \x01(\tH\x00R\x05owner\x88\x01\x01\x12C\n" +
+	"\x05param\x18\x04 \x03(\v2-.taskstoredb.cluster.v1.AddRequest.ParamEntryR\x05param\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_owner\"\x1d\n" +
+	"\vAddResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf4\x01\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12F\n" +
+	"\x05param\x18\x04 \x03(\v20.taskstoredb.cluster.v1.UpdateRequest.ParamEntryR\x05param\x12\x19\n" +
+	"\x05error\x18\x05 \x01(\tH\x00R\x05error\x88\x01\x01\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_error\"K\n" +
+	"\x0fAddBatchRequest\x128\n" +
+	"\x05tasks\x18\x01 \x03(\v2\".taskstoredb.cluster.v1.AddRequestR\x05tasks\"Q\n" +
+	"\x12UpdateBatchRequest\x12;\n" +
+	"\x05tasks\x18\x01 \x03(\v2%.taskstoredb.cluster.v1.UpdateRequestR\x05tasks\"@\n" +
+	"\tBatchItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05error\x18\x02 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"H\n" +
+	"\rBatchResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.taskstoredb.cluster.v1.BatchItemR\x05items\"2\n" +
+	"\n" +
+	"GetRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"M\n" +
+	"\vGetResponse\x125\n" +
+	"\x04task\x18\x01 \x01(\v2\x1c.taskstoredb.cluster.v1.TaskH\x00R\x04task\x88\x01\x01B\a\n" +
+	"\x05_task\".\n" +
+	"\x16GetFirstInGroupRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\")\n" +
+	"\x17GetFirstInGroupResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"l\n" +
+	"\x0fOwnerRegRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x14\n" +
+	"\x05kinds\x18\x02 \x03(\tR\x05kinds\x12\x15\n" +
+	"\x06ttl_ms\x18\x03 \x01(\x03R\x05ttlMs\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\"D\n" +
+	"\x15OwnerHeartbeatRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x15\n" +
+	"\x06ttl_ms\x18\x02 \x01(\x03R\x05ttlMs\"W\n" +
+	"\x11OwnerUnRegRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\ahandoff\x18\x03 \x01(\bR\ahandoff\"P\n" +
+	"\fPauseRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"\xc3\x01\n" +
+	"\n" +
+	"KindConfig\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\"\n" +
+	"\rmax_in_flight\x18\x03 \x01(\x05R\vmaxInFlight\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12\x1a\n" +
+	"\bstrategy\x18\x05 \x01(\tR\bstrategy\x12\x1d\n" +
+	"\n" +
+	"hash_param\x18\x06 \x01(\tR\thashParam\x12\x14\n" +
+	"\x05steal\x18\a \x01(\bR\x05steal\"%\n" +
+	"\x0fInFlightRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"(\n" +
+	"\x10InFlightResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"f\n" +
+	"\vPoolRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\await_ms\x18\x03 \x01(\x03R\x06waitMs\x12\x14\n" +
+	"\x05lease\x18\x04 \x01(\bR\x05lease\"q\n" +
+	"\rSearchRequest\x12\x1c\n" +
+	"\tcondition\x18\x01 \x01(\fR\tcondition\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x03 \x01(\x04H\x01R\x04size\x88\x01\x01B\a\n" +
+	"\x05_kindB\a\n" +
+	"\x05_size\"\xab\x01\n" +
+	"\x13SearchUpdateRequest\x122\n" +
+	"\x02up\x18\x01 \x01(\v2\".taskstoredb.cluster.v1.TaskUpdateR\x02up\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\fR\tcondition\x12\x17\n" +
+	"\x04kind\x18\x03 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x04 \x01(\x04H\x01R\x04size\x88\x01\x01B\a\n" +
+	"\x05_kindB\a\n" +
+	"\x05_size\"u\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05kinds\x18\x03 \x03(\tR\x05kinds\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\x05R\bstatuses\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\"%\n" +
+	"\x13WebhookUnRegRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x85\x01\n" +
+	"\tNamespace\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmax_tasks\x18\x02 \x01(\x03R\bmaxTasks\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes\x12\x14\n" +
+	"\x05tasks\x18\x04 \x01(\x03R\x05tasks\x12\x14\n" +
+	"\x05bytes\x18\x05 \x01(\x03R\x05bytes\"*\n" +
+	"\x14NamespaceDropRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"W\n" +
+	"\x12NamespacesResponse\x12A\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2!.taskstoredb.cluster.v1.NamespaceR\n" +
+	"namespaces\"[\n" +
+	"\rEventsRequest\x12\x1c\n" +
+	"\tcondition\x18\x01 \x01(\fR\tcondition\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x04R\x05index\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"\x8d\x01\n" +
+	"\tTaskEvent\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x04R\x05index\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x125\n" +
+	"\x04task\x18\x04 \x01(\v2\x1c.taskstoredb.cluster.v1.TaskH\x00R\x04task\x88\x01\x01B\a\n" +
+	"\x05_task2\xcd\x11\n" +
+	"\aCluster\x12N\n" +
+	"\x03Add\x12\".taskstoredb.cluster.v1.AddRequest\x1a#.taskstoredb.cluster.v1.AddResponse\x12N\n" +
+	"\x06Update\x12%.taskstoredb.cluster.v1.UpdateRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12Z\n" +
+	"\bAddBatch\x12'.taskstoredb.cluster.v1.AddBatchRequest\x1a%.taskstoredb.cluster.v1.BatchResponse\x12`\n" +
+	"\vUpdateBatch\x12*.taskstoredb.cluster.v1.UpdateBatchRequest\x1a%.taskstoredb.cluster.v1.BatchResponse\x12N\n" +
+	"\x03Get\x12\".taskstoredb.cluster.v1.GetRequest\x1a#.taskstoredb.cluster.v1.GetResponse\x12r\n" +
+	"\x0fGetFirstInGroup\x12..taskstoredb.cluster.v1.GetFirstInGroupRequest\x1a/.taskstoredb.cluster.v1.GetFirstInGroupResponse\x12R\n" +
+	"\bOwnerReg\x12'.taskstoredb.cluster.v1.OwnerRegRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12V\n" +
+	"\n" +
+	"OwnerUnReg\x12).taskstoredb.cluster.v1.OwnerUnRegRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12^\n" +
+	"\x0eOwnerHeartbeat\x12-.taskstoredb.cluster.v1.OwnerHeartbeatRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12P\n" +
+	"\x04Pool\x12#.taskstoredb.cluster.v1.PoolRequest\x1a!.taskstoredb.cluster.v1.TaskChunk0\x01\x12X\n" +
+	"\n" +
+	"SearchTask\x12%.taskstoredb.cluster.v1.SearchRequest\x1a!.taskstoredb.cluster.v1.TaskChunk0\x01\x12]\n" +
+	"\x0fSearchErrorTask\x12%.taskstoredb.cluster.v1.SearchRequest\x1a!.taskstoredb.cluster.v1.TaskChunk0\x01\x12X\n" +
+	"\x10SearchDeleteTask\x12%.taskstoredb.cluster.v1.SearchRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12]\n" +
+	"\x15SearchDeleteErrorTask\x12%.taskstoredb.cluster.v1.SearchRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12^\n" +
+	"\x10SearchUpdateTask\x12+.taskstoredb.cluster.v1.SearchUpdateRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12c\n" +
+	"\x15SearchUpdateErrorTask\x12+.taskstoredb.cluster.v1.SearchUpdateRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12L\n" +
+	"\n" +
+	"WebhookReg\x12\x1f.taskstoredb.cluster.v1.Webhook\x1a\x1d.taskstoredb.cluster.v1.Empty\x12Z\n" +
+	"\fWebhookUnReg\x12+.taskstoredb.cluster.v1.WebhookUnRegRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12L\n" +
+	"\x05Pause\x12$.taskstoredb.cluster.v1.PauseRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12R\n" +
+	"\rKindConfigSet\x12\".taskstoredb.cluster.v1.KindConfig\x1a\x1d.taskstoredb.cluster.v1.Empty\x12]\n" +
+	"\bInFlight\x12'.taskstoredb.cluster.v1.InFlightRequest\x1a(.taskstoredb.cluster.v1.InFlightResponse\x12S\n" +
+	"\x0fNamespaceCreate\x12!.taskstoredb.cluster.v1.Namespace\x1a\x1d.taskstoredb.cluster.v1.Empty\x12\\\n" +
+	"\rNamespaceDrop\x12,.taskstoredb.cluster.v1.NamespaceDropRequest\x1a\x1d.taskstoredb.cluster.v1.Empty\x12W\n" +
+	"\n" +
+	"Namespaces\x12\x1d.taskstoredb.cluster.v1.Empty\x1a*.taskstoredb.cluster.v1.NamespacesResponse\x12T\n" +
+	"\x06Events\x12%.taskstoredb.cluster.v1.EventsRequest\x1a!.taskstoredb.cluster.v1.TaskEvent0\x01B@Z>github.com/esaseleznev/taskstoredb/internal/contract/clusterpbb\x06proto3"
+
+var (
+	file_cluster_v1_cluster_proto_rawDescOnce sync.Once
+	file_cluster_v1_cluster_proto_rawDescData []byte
+)
+
+func file_cluster_v1_cluster_proto_rawDescGZIP() []byte {
+	file_cluster_v1_cluster_proto_rawDescOnce.Do(func() {
+		file_cluster_v1_cluster_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_v1_cluster_proto_rawDesc), len(file_cluster_v1_cluster_proto_rawDesc)))
+	})
+	return file_cluster_v1_cluster_proto_rawDescData
+}
+
+var file_cluster_v1_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_cluster_v1_cluster_proto_goTypes = []any{
+	(*Empty)(nil),                   // 0: taskstoredb.cluster.v1.Empty
+	(*Task)(nil),                    // 1: taskstoredb.cluster.v1.Task
+	(*TaskChunk)(nil),               // 2: taskstoredb.cluster.v1.TaskChunk
+	(*TaskUpdate)(nil),              // 3: taskstoredb.cluster.v1.TaskUpdate
+	(*AddRequest)(nil),              // 4: taskstoredb.cluster.v1.AddRequest
+	(*AddResponse)(nil),             // 5: taskstoredb.cluster.v1.AddResponse
+	(*UpdateRequest)(nil),           // 6: taskstoredb.cluster.v1.UpdateRequest
+	(*AddBatchRequest)(nil),         // 7: taskstoredb.cluster.v1.AddBatchRequest
+	(*UpdateBatchRequest)(nil),      // 8: taskstoredb.cluster.v1.UpdateBatchRequest
+	(*BatchItem)(nil),               // 9: taskstoredb.cluster.v1.BatchItem
+	(*BatchResponse)(nil),           // 10: taskstoredb.cluster.v1.BatchResponse
+	(*GetRequest)(nil),              // 11: taskstoredb.cluster.v1.GetRequest
+	(*GetResponse)(nil),             // 12: taskstoredb.cluster.v1.GetResponse
+	(*GetFirstInGroupRequest)(nil),  // 13: taskstoredb.cluster.v1.GetFirstInGroupRequest
+	(*GetFirstInGroupResponse)(nil), // 14: taskstoredb.cluster.v1.GetFirstInGroupResponse
+	(*OwnerRegRequest)(nil),         // 15: taskstoredb.cluster.v1.OwnerRegRequest
+	(*OwnerHeartbeatRequest)(nil),   // 16: taskstoredb.cluster.v1.OwnerHeartbeatRequest
+	(*OwnerUnRegRequest)(nil),       // 17: taskstoredb.cluster.v1.OwnerUnRegRequest
+	(*PauseRequest)(nil),            // 18: taskstoredb.cluster.v1.PauseRequest
+	(*KindConfig)(nil),              // 19: taskstoredb.cluster.v1.KindConfig
+	(*InFlightRequest)(nil),         // 20: taskstoredb.cluster.v1.InFlightRequest
+	(*InFlightResponse)(nil),        // 21: taskstoredb.cluster.v1.InFlightResponse
+	(*PoolRequest)(nil),             // 22: taskstoredb.cluster.v1.PoolRequest
+	(*SearchRequest)(nil),           // 23: taskstoredb.cluster.v1.SearchRequest
+	(*SearchUpdateRequest)(nil),     // 24: taskstoredb.cluster.v1.SearchUpdateRequest
+	(*Webhook)(nil),                 // 25: taskstoredb.cluster.v1.Webhook
+	(*WebhookUnRegRequest)(nil),     // 26: taskstoredb.cluster.v1.WebhookUnRegRequest
+	(*Namespace)(nil),               // 27: taskstoredb.cluster.v1.Namespace
+	(*NamespaceDropRequest)(nil),    // 28: taskstoredb.cluster.v1.NamespaceDropRequest
+	(*NamespacesResponse)(nil),      // 29: taskstoredb.cluster.v1.NamespacesResponse
+	(*EventsRequest)(nil),           // 30: taskstoredb.cluster.v1.EventsRequest
+	(*TaskEvent)(nil),               // 31: taskstoredb.cluster.v1.TaskEvent
+	nil,                             // 32: taskstoredb.cluster.v1.Task.ParamEntry
+	nil,                             // 33: taskstoredb.cluster.v1.TaskUpdate.ParamEntry
+	nil,                             // 34: taskstoredb.cluster.v1.AddRequest.ParamEntry
+	nil,                             // 35: taskstoredb.cluster.v1.UpdateRequest.ParamEntry
+	(*timestamppb.Timestamp)(nil),   // 36: google.protobuf.Timestamp
+}
+var file_cluster_v1_cluster_proto_depIdxs = []int32{
+	32, // 0: taskstoredb.cluster.v1.Task.param:type_name -> taskstoredb.cluster.v1.Task.ParamEntry
+	36, // 1: taskstoredb.cluster.v1.Task.ts:type_name -> google.protobuf.Timestamp
+	1,  // 2: taskstoredb.cluster.v1.TaskChunk.tasks:type_name -> taskstoredb.cluster.v1.Task
+	33, // 3: taskstoredb.cluster.v1.TaskUpdate.param:type_name -> taskstoredb.cluster.v1.TaskUpdate.ParamEntry
+	34, // 4: taskstoredb.cluster.v1.AddRequest.param:type_name -> taskstoredb.cluster.v1.AddRequest.ParamEntry
+	35, // 5: taskstoredb.cluster.v1.UpdateRequest.param:type_name -> taskstoredb.cluster.v1.UpdateRequest.ParamEntry
+	4,  // 6: taskstoredb.cluster.v1.AddBatchRequest.tasks:type_name -> taskstoredb.cluster.v1.AddRequest
+	6,  // 7: taskstoredb.cluster.v1.UpdateBatchRequest.tasks:type_name -> taskstoredb.cluster.v1.UpdateRequest
+	9,  // 8: taskstoredb.cluster.v1.BatchResponse.items:type_name -> taskstoredb.cluster.v1.BatchItem
+	1,  // 9: taskstoredb.cluster.v1.GetResponse.task:type_name -> taskstoredb.cluster.v1.Task
+	3,  // 10: taskstoredb.cluster.v1.SearchUpdateRequest.up:type_name -> taskstoredb.cluster.v1.TaskUpdate
+	27, // 11: taskstoredb.cluster.v1.NamespacesResponse.namespaces:type_name -> taskstoredb.cluster.v1.Namespace
+	1,  // 12: taskstoredb.cluster.v1.TaskEvent.task:type_name -> taskstoredb.cluster.v1.Task
+	4,  // 13: taskstoredb.cluster.v1.Cluster.Add:input_type -> taskstoredb.cluster.v1.AddRequest
+	6,  // 14: taskstoredb.cluster.v1.Cluster.Update:input_type -> taskstoredb.cluster.v1.UpdateRequest
+	7,  // 15: taskstoredb.cluster.v1.Cluster.AddBatch:input_type -> taskstoredb.cluster.v1.AddBatchRequest
+	8,  // 16: taskstoredb.cluster.v1.Cluster.UpdateBatch:input_type -> taskstoredb.cluster.v1.UpdateBatchRequest
+	11, // 17: taskstoredb.cluster.v1.Cluster.Get:input_type -> taskstoredb.cluster.v1.GetRequest
+	13, // 18: taskstoredb.cluster.v1.Cluster.GetFirstInGroup:input_type -> taskstoredb.cluster.v1.GetFirstInGroupRequest
+	15, // 19: taskstoredb.cluster.v1.Cluster.OwnerReg:input_type -> taskstoredb.cluster.v1.OwnerRegRequest
+	17, // 20: taskstoredb.cluster.v1.Cluster.OwnerUnReg:input_type -> taskstoredb.cluster.v1.OwnerUnRegRequest
+	16, // 21: taskstoredb.cluster.v1.Cluster.OwnerHeartbeat:input_type -> taskstoredb.cluster.v1.OwnerHeartbeatRequest
+	22, // 22: taskstoredb.cluster.v1.Cluster.Pool:input_type -> taskstoredb.cluster.v1.PoolRequest
+	23, // 23: taskstoredb.cluster.v1.Cluster.SearchTask:input_type -> taskstoredb.cluster.v1.SearchRequest
+	23, // 24: taskstoredb.cluster.v1.Cluster.SearchErrorTask:input_type -> taskstoredb.cluster.v1.SearchRequest
+	23, // 25: taskstoredb.cluster.v1.Cluster.SearchDeleteTask:input_type -> taskstoredb.cluster.v1.SearchRequest
+	23, // 26: taskstoredb.cluster.v1.Cluster.SearchDeleteErrorTask:input_type -> taskstoredb.cluster.v1.SearchRequest
+	24, // 27: taskstoredb.cluster.v1.Cluster.SearchUpdateTask:input_type -> taskstoredb.cluster.v1.SearchUpdateRequest
+	24, // 28: taskstoredb.cluster.v1.Cluster.SearchUpdateErrorTask:input_type -> taskstoredb.cluster.v1.SearchUpdateRequest
+	25, // 29: taskstoredb.cluster.v1.Cluster.WebhookReg:input_type -> taskstoredb.cluster.v1.Webhook
+	26, // 30: taskstoredb.cluster.v1.Cluster.WebhookUnReg:input_type -> taskstoredb.cluster.v1.WebhookUnRegRequest
+	18, // 31: taskstoredb.cluster.v1.Cluster.Pause:input_type -> taskstoredb.cluster.v1.PauseRequest
+	19, // 32: taskstoredb.cluster.v1.Cluster.KindConfigSet:input_type -> taskstoredb.cluster.v1.KindConfig
+	20, // 33: taskstoredb.cluster.v1.Cluster.InFlight:input_type -> taskstoredb.cluster.v1.InFlightRequest
+	27, // 34: taskstoredb.cluster.v1.Cluster.NamespaceCreate:input_type -> taskstoredb.cluster.v1.Namespace
+	28, // 35: taskstoredb.cluster.v1.Cluster.NamespaceDrop:input_type -> taskstoredb.cluster.v1.NamespaceDropRequest
+	0,  // 36: taskstoredb.cluster.v1.Cluster.Namespaces:input_type -> taskstoredb.cluster.v1.Empty
+	30, // 37: taskstoredb.cluster.v1.Cluster.Events:input_type -> taskstoredb.cluster.v1.EventsRequest
+	5,  // 38: taskstoredb.cluster.v1.Cluster.Add:output_type -> taskstoredb.cluster.v1.AddResponse
+	0,  // 39: taskstoredb.cluster.v1.Cluster.Update:output_type -> taskstoredb.cluster.v1.Empty
+	10, // 40: taskstoredb.cluster.v1.Cluster.AddBatch:output_type -> taskstoredb.cluster.v1.BatchResponse
+	10, // 41: taskstoredb.cluster.v1.Cluster.UpdateBatch:output_type -> taskstoredb.cluster.v1.BatchResponse
+	12, // 42: taskstoredb.cluster.v1.Cluster.Get:output_type -> taskstoredb.cluster.v1.GetResponse
+	14, // 43: taskstoredb.cluster.v1.Cluster.GetFirstInGroup:output_type -> taskstoredb.cluster.v1.GetFirstInGroupResponse
+	0,  // 44: taskstoredb.cluster.v1.Cluster.OwnerReg:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 45: taskstoredb.cluster.v1.Cluster.OwnerUnReg:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 46: taskstoredb.cluster.v1.Cluster.OwnerHeartbeat:output_type -> taskstoredb.cluster.v1.Empty
+	2,  // 47: taskstoredb.cluster.v1.Cluster.Pool:output_type -> taskstoredb.cluster.v1.TaskChunk
+	2,  // 48: taskstoredb.cluster.v1.Cluster.SearchTask:output_type -> taskstoredb.cluster.v1.TaskChunk
+	2,  // 49: taskstoredb.cluster.v1.Cluster.SearchErrorTask:output_type -> taskstoredb.cluster.v1.TaskChunk
+	0,  // 50: taskstoredb.cluster.v1.Cluster.SearchDeleteTask:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 51: taskstoredb.cluster.v1.Cluster.SearchDeleteErrorTask:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 52: taskstoredb.cluster.v1.Cluster.SearchUpdateTask:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 53: taskstoredb.cluster.v1.Cluster.SearchUpdateErrorTask:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 54: taskstoredb.cluster.v1.Cluster.WebhookReg:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 55: taskstoredb.cluster.v1.Cluster.WebhookUnReg:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 56: taskstoredb.cluster.v1.Cluster.Pause:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 57: taskstoredb.cluster.v1.Cluster.KindConfigSet:output_type -> taskstoredb.cluster.v1.Empty
+	21, // 58: taskstoredb.cluster.v1.Cluster.InFlight:output_type -> taskstoredb.cluster.v1.InFlightResponse
+	0,  // 59: taskstoredb.cluster.v1.Cluster.NamespaceCreate:output_type -> taskstoredb.cluster.v1.Empty
+	0,  // 60: taskstoredb.cluster.v1.Cluster.NamespaceDrop:output_type -> taskstoredb.cluster.v1.Empty
+	29, // 61: taskstoredb.cluster.v1.Cluster.Namespaces:output_type -> taskstoredb.cluster.v1.NamespacesResponse
+	31, // 62: taskstoredb.cluster.v1.Cluster.Events:output_type -> taskstoredb.cluster.v1.TaskEvent
+	38, // [38:63] is the sub-list for method output_type
+	13, // [13:38] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_cluster_v1_cluster_proto_init() }
+func file_cluster_v1_cluster_proto_init() {
+	if File_cluster_v1_cluster_proto != nil {
+		return
+	}
+	file_cluster_v1_cluster_proto_msgTypes[1].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[3].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[4].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[6].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[9].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[12].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[23].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[24].OneofWrappers = []any{}
+	file_cluster_v1_cluster_proto_msgTypes[31].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_v1_cluster_proto_rawDesc), len(file_cluster_v1_cluster_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_v1_cluster_proto_goTypes,
+		DependencyIndexes: file_cluster_v1_cluster_proto_depIdxs,
+		MessageInfos:      file_cluster_v1_cluster_proto_msgTypes,
+	}.Build()
+	File_cluster_v1_cluster_proto = out.File
+	file_cluster_v1_cluster_proto_goTypes = nil
+	file_cluster_v1_cluster_proto_depIdxs = nil
+}
@@ -0,0 +1,1054 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/v1/cluster.proto
+
+package clusterpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Cluster_Add_FullMethodName                   = "/taskstoredb.cluster.v1.Cluster/Add"
+	Cluster_Update_FullMethodName                = "/taskstoredb.cluster.v1.Cluster/Update"
+	Cluster_AddBatch_FullMethodName              = "/taskstoredb.cluster.v1.Cluster/AddBatch"
+	Cluster_UpdateBatch_FullMethodName           = "/taskstoredb.cluster.v1.Cluster/UpdateBatch"
+	Cluster_Get_FullMethodName                   = "/taskstoredb.cluster.v1.Cluster/Get"
+	Cluster_GetFirstInGroup_FullMethodName       = "/taskstoredb.cluster.v1.Cluster/GetFirstInGroup"
+	Cluster_OwnerReg_FullMethodName              = "/taskstoredb.cluster.v1.Cluster/OwnerReg"
+	Cluster_OwnerUnReg_FullMethodName            = "/taskstoredb.cluster.v1.Cluster/OwnerUnReg"
+	Cluster_OwnerHeartbeat_FullMethodName        = "/taskstoredb.cluster.v1.Cluster/OwnerHeartbeat"
+	Cluster_Pool_FullMethodName                  = "/taskstoredb.cluster.v1.Cluster/Pool"
+	Cluster_SearchTask_FullMethodName            = "/taskstoredb.cluster.v1.Cluster/SearchTask"
+	Cluster_SearchErrorTask_FullMethodName       = "/taskstoredb.cluster.v1.Cluster/SearchErrorTask"
+	Cluster_SearchDeleteTask_FullMethodName      = "/taskstoredb.cluster.v1.Cluster/SearchDeleteTask"
+	Cluster_SearchDeleteErrorTask_FullMethodName = "/taskstoredb.cluster.v1.Cluster/SearchDeleteErrorTask"
+	Cluster_SearchUpdateTask_FullMethodName      = "/taskstoredb.cluster.v1.Cluster/SearchUpdateTask"
+	Cluster_SearchUpdateErrorTask_FullMethodName = "/taskstoredb.cluster.v1.Cluster/SearchUpdateErrorTask"
+	Cluster_WebhookReg_FullMethodName            = "/taskstoredb.cluster.v1.Cluster/WebhookReg"
+	Cluster_WebhookUnReg_FullMethodName          = "/taskstoredb.cluster.v1.Cluster/WebhookUnReg"
+	Cluster_Pause_FullMethodName                 = "/taskstoredb.cluster.v1.Cluster/Pause"
+	Cluster_KindConfigSet_FullMethodName         = "/taskstoredb.cluster.v1.Cluster/KindConfigSet"
+	Cluster_InFlight_FullMethodName              = "/taskstoredb.cluster.v1.Cluster/InFlight"
+	Cluster_NamespaceCreate_FullMethodName       = "/taskstoredb.cluster.v1.Cluster/NamespaceCreate"
+	Cluster_NamespaceDrop_FullMethodName         = "/taskstoredb.cluster.v1.Cluster/NamespaceDrop"
+	Cluster_Namespaces_FullMethodName            = "/taskstoredb.cluster.v1.Cluster/Namespaces"
+	Cluster_Events_FullMethodName                = "/taskstoredb.cluster.v1.Cluster/Events"
+)
+
+// ClusterClient is the client API for Cluster service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Cluster is the node-to-node transport, every call is served by the local store
+// of the receiving node, the same as the internal flag of the http api.
+// The namespace of a call is sent as x-namespace metadata.
+type ClusterClient interface {
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	AddBatch(ctx context.Context, in *AddBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	GetFirstInGroup(ctx context.Context, in *GetFirstInGroupRequest, opts ...grpc.CallOption) (*GetFirstInGroupResponse, error)
+	OwnerReg(ctx context.Context, in *OwnerRegRequest, opts ...grpc.CallOption) (*Empty, error)
+	OwnerUnReg(ctx context.Context, in *OwnerUnRegRequest, opts ...grpc.CallOption) (*Empty, error)
+	OwnerHeartbeat(ctx context.Context, in *OwnerHeartbeatRequest, opts ...grpc.CallOption) (*Empty, error)
+	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskChunk], error)
+	SearchTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskChunk], error)
+	SearchErrorTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskChunk], error)
+	SearchDeleteTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error)
+	SearchDeleteErrorTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error)
+	SearchUpdateTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	SearchUpdateErrorTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	WebhookReg(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Empty, error)
+	WebhookUnReg(ctx context.Context, in *WebhookUnRegRequest, opts ...grpc.CallOption) (*Empty, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Empty, error)
+	KindConfigSet(ctx context.Context, in *KindConfig, opts ...grpc.CallOption) (*Empty, error)
+	InFlight(ctx context.Context, in *InFlightRequest, opts ...grpc.CallOption) (*InFlightResponse, error)
+	NamespaceCreate(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Empty, error)
+	NamespaceDrop(ctx context.Context, in *NamespaceDropRequest, opts ...grpc.CallOption) (*Empty, error)
+	Namespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error)
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskEvent], error)
+}
+
+type clusterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterClient(cc grpc.ClientConnInterface) ClusterClient {
+	return &clusterClient{cc}
+}
+
+func (c *clusterClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, Cluster_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) AddBatch(ctx context.Context, in *AddBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, Cluster_AddBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, Cluster_UpdateBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, Cluster_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) GetFirstInGroup(ctx context.Context, in *GetFirstInGroupRequest, opts ...grpc.CallOption) (*GetFirstInGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFirstInGroupResponse)
+	err := c.cc.Invoke(ctx, Cluster_GetFirstInGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) OwnerReg(ctx context.Context, in *OwnerRegRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_OwnerReg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) OwnerUnReg(ctx context.Context, in *OwnerUnRegRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_OwnerUnReg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) OwnerHeartbeat(ctx context.Context, in *OwnerHeartbeatRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_OwnerHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[0], Cluster_Pool_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PoolRequest, TaskChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_PoolClient = grpc.ServerStreamingClient[TaskChunk]
+
+func (c *clusterClient) SearchTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[1], Cluster_SearchTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchRequest, TaskChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_SearchTaskClient = grpc.ServerStreamingClient[TaskChunk]
+
+func (c *clusterClient) SearchErrorTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[2], Cluster_SearchErrorTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchRequest, TaskChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_SearchErrorTaskClient = grpc.ServerStreamingClient[TaskChunk]
+
+func (c *clusterClient) SearchDeleteTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_SearchDeleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) SearchDeleteErrorTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_SearchDeleteErrorTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) SearchUpdateTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_SearchUpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) SearchUpdateErrorTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_SearchUpdateErrorTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) WebhookReg(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_WebhookReg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) WebhookUnReg(ctx context.Context, in *WebhookUnRegRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_WebhookUnReg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) KindConfigSet(ctx context.Context, in *KindConfig, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_KindConfigSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) InFlight(ctx context.Context, in *InFlightRequest, opts ...grpc.CallOption) (*InFlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InFlightResponse)
+	err := c.cc.Invoke(ctx, Cluster_InFlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) NamespaceCreate(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_NamespaceCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) NamespaceDrop(ctx context.Context, in *NamespaceDropRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Cluster_NamespaceDrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Namespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespacesResponse)
+	err := c.cc.Invoke(ctx, Cluster_Namespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[3], Cluster_Events_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EventsRequest, TaskEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_EventsClient = grpc.ServerStreamingClient[TaskEvent]
+
+// ClusterServer is the server API for Cluster service.
+// All implementations must embed UnimplementedClusterServer
+// for forward compatibility.
+//
+// Cluster is the node-to-node transport, every call is served by the local store
+// of the receiving node, the same as the internal flag of the http api.
+// The namespace of a call is sent as x-namespace metadata.
+type ClusterServer interface {
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	Update(context.Context, *UpdateRequest) (*Empty, error)
+	AddBatch(context.Context, *AddBatchRequest) (*BatchResponse, error)
+	UpdateBatch(context.Context, *UpdateBatchRequest) (*BatchResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	GetFirstInGroup(context.Context, *GetFirstInGroupRequest) (*GetFirstInGroupResponse, error)
+	OwnerReg(context.Context, *OwnerRegRequest) (*Empty, error)
+	OwnerUnReg(context.Context, *OwnerUnRegRequest) (*Empty, error)
+	OwnerHeartbeat(context.Context, *OwnerHeartbeatRequest) (*Empty, error)
+	Pool(*PoolRequest, grpc.ServerStreamingServer[TaskChunk]) error
+	SearchTask(*SearchRequest, grpc.ServerStreamingServer[TaskChunk]) error
+	SearchErrorTask(*SearchRequest, grpc.ServerStreamingServer[TaskChunk]) error
+	SearchDeleteTask(context.Context, *SearchRequest) (*Empty, error)
+	SearchDeleteErrorTask(context.Context, *SearchRequest) (*Empty, error)
+	SearchUpdateTask(context.Context, *SearchUpdateRequest) (*Empty, error)
+	SearchUpdateErrorTask(context.Context, *SearchUpdateRequest) (*Empty, error)
+	WebhookReg(context.Context, *Webhook) (*Empty, error)
+	WebhookUnReg(context.Context, *WebhookUnRegRequest) (*Empty, error)
+	Pause(context.Context, *PauseRequest) (*Empty, error)
+	KindConfigSet(context.Context, *KindConfig) (*Empty, error)
+	InFlight(context.Context, *InFlightRequest) (*InFlightResponse, error)
+	NamespaceCreate(context.Context, *Namespace) (*Empty, error)
+	NamespaceDrop(context.Context, *NamespaceDropRequest) (*Empty, error)
+	Namespaces(context.Context, *Empty) (*NamespacesResponse, error)
+	Events(*EventsRequest, grpc.ServerStreamingServer[TaskEvent]) error
+	mustEmbedUnimplementedClusterServer()
+}
+
+// UnimplementedClusterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClusterServer struct{}
+
+func (UnimplementedClusterServer) Add(context.Context, *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedClusterServer) Update(context.Context, *UpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedClusterServer) AddBatch(context.Context, *AddBatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBatch not implemented")
+}
+func (UnimplementedClusterServer) UpdateBatch(context.Context, *UpdateBatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatch not implemented")
+}
+func (UnimplementedClusterServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedClusterServer) GetFirstInGroup(context.Context, *GetFirstInGroupRequest) (*GetFirstInGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFirstInGroup not implemented")
+}
+func (UnimplementedClusterServer) OwnerReg(context.Context, *OwnerRegRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerReg not implemented")
+}
+func (UnimplementedClusterServer) OwnerUnReg(context.Context, *OwnerUnRegRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerUnReg not implemented")
+}
+func (UnimplementedClusterServer) OwnerHeartbeat(context.Context, *OwnerHeartbeatRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerHeartbeat not implemented")
+}
+func (UnimplementedClusterServer) Pool(*PoolRequest, grpc.ServerStreamingServer[TaskChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (UnimplementedClusterServer) SearchTask(*SearchRequest, grpc.ServerStreamingServer[TaskChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchTask not implemented")
+}
+func (UnimplementedClusterServer) SearchErrorTask(*SearchRequest, grpc.ServerStreamingServer[TaskChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchErrorTask not implemented")
+}
+func (UnimplementedClusterServer) SearchDeleteTask(context.Context, *SearchRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDeleteTask not implemented")
+}
+func (UnimplementedClusterServer) SearchDeleteErrorTask(context.Context, *SearchRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDeleteErrorTask not implemented")
+}
+func (UnimplementedClusterServer) SearchUpdateTask(context.Context, *SearchUpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUpdateTask not implemented")
+}
+func (UnimplementedClusterServer) SearchUpdateErrorTask(context.Context, *SearchUpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUpdateErrorTask not implemented")
+}
+func (UnimplementedClusterServer) WebhookReg(context.Context, *Webhook) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookReg not implemented")
+}
+func (UnimplementedClusterServer) WebhookUnReg(context.Context, *WebhookUnRegRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookUnReg not implemented")
+}
+func (UnimplementedClusterServer) Pause(context.Context, *PauseRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedClusterServer) KindConfigSet(context.Context, *KindConfig) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KindConfigSet not implemented")
+}
+func (UnimplementedClusterServer) InFlight(context.Context, *InFlightRequest) (*InFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlight not implemented")
+}
+func (UnimplementedClusterServer) NamespaceCreate(context.Context, *Namespace) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceCreate not implemented")
+}
+func (UnimplementedClusterServer) NamespaceDrop(context.Context, *NamespaceDropRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceDrop not implemented")
+}
+func (UnimplementedClusterServer) Namespaces(context.Context, *Empty) (*NamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespaces not implemented")
+}
+func (UnimplementedClusterServer) Events(*EventsRequest, grpc.ServerStreamingServer[TaskEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedClusterServer) mustEmbedUnimplementedClusterServer() {}
+func (UnimplementedClusterServer) testEmbeddedByValue()                 {}
+
+// UnsafeClusterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterServer will
+// result in compilation errors.
+type UnsafeClusterServer interface {
+	mustEmbedUnimplementedClusterServer()
+}
+
+func RegisterClusterServer(s grpc.ServiceRegistrar, srv ClusterServer) {
+	// If the following call pancis, it indicates UnimplementedClusterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Cluster_ServiceDesc, srv)
+}
+
+func _Cluster_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_AddBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).AddBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_AddBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).AddBatch(ctx, req.(*AddBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_UpdateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).UpdateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_UpdateBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).UpdateBatch(ctx, req.(*UpdateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_GetFirstInGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFirstInGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetFirstInGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_GetFirstInGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetFirstInGroup(ctx, req.(*GetFirstInGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_OwnerReg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerRegRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).OwnerReg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_OwnerReg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).OwnerReg(ctx, req.(*OwnerRegRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_OwnerUnReg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerUnRegRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).OwnerUnReg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_OwnerUnReg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).OwnerUnReg(ctx, req.(*OwnerUnRegRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_OwnerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).OwnerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_OwnerHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).OwnerHeartbeat(ctx, req.(*OwnerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Pool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PoolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).Pool(m, &grpc.GenericServerStream[PoolRequest, TaskChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_PoolServer = grpc.ServerStreamingServer[TaskChunk]
+
+func _Cluster_SearchTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).SearchTask(m, &grpc.GenericServerStream[SearchRequest, TaskChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_SearchTaskServer = grpc.ServerStreamingServer[TaskChunk]
+
+func _Cluster_SearchErrorTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).SearchErrorTask(m, &grpc.GenericServerStream[SearchRequest, TaskChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_SearchErrorTaskServer = grpc.ServerStreamingServer[TaskChunk]
+
+func _Cluster_SearchDeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).SearchDeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_SearchDeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).SearchDeleteTask(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_SearchDeleteErrorTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).SearchDeleteErrorTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_SearchDeleteErrorTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).SearchDeleteErrorTask(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_SearchUpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).SearchUpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_SearchUpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).SearchUpdateTask(ctx, req.(*SearchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_SearchUpdateErrorTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).SearchUpdateErrorTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_SearchUpdateErrorTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).SearchUpdateErrorTask(ctx, req.(*SearchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_WebhookReg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).WebhookReg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_WebhookReg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).WebhookReg(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_WebhookUnReg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookUnRegRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).WebhookUnReg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_WebhookUnReg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).WebhookUnReg(ctx, req.(*WebhookUnRegRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_KindConfigSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KindConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).KindConfigSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_KindConfigSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).KindConfigSet(ctx, req.(*KindConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_InFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).InFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_InFlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).InFlight(ctx, req.(*InFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_NamespaceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Namespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).NamespaceCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_NamespaceCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).NamespaceCreate(ctx, req.(*Namespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_NamespaceDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).NamespaceDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_NamespaceDrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).NamespaceDrop(ctx, req.(*NamespaceDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Namespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Namespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_Namespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Namespaces(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).Events(m, &grpc.GenericServerStream[EventsRequest, TaskEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_EventsServer = grpc.ServerStreamingServer[TaskEvent]
+
+// Cluster_ServiceDesc is the grpc.ServiceDesc for Cluster service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cluster_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taskstoredb.cluster.v1.Cluster",
+	HandlerType: (*ClusterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _Cluster_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Cluster_Update_Handler,
+		},
+		{
+			MethodName: "AddBatch",
+			Handler:    _Cluster_AddBatch_Handler,
+		},
+		{
+			MethodName: "UpdateBatch",
+			Handler:    _Cluster_UpdateBatch_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Cluster_Get_Handler,
+		},
+		{
+			MethodName: "GetFirstInGroup",
+			Handler:    _Cluster_GetFirstInGroup_Handler,
+		},
+		{
+			MethodName: "OwnerReg",
+			Handler:    _Cluster_OwnerReg_Handler,
+		},
+		{
+			MethodName: "OwnerUnReg",
+			Handler:    _Cluster_OwnerUnReg_Handler,
+		},
+		{
+			MethodName: "OwnerHeartbeat",
+			Handler:    _Cluster_OwnerHeartbeat_Handler,
+		},
+		{
+			MethodName: "SearchDeleteTask",
+			Handler:    _Cluster_SearchDeleteTask_Handler,
+		},
+		{
+			MethodName: "SearchDeleteErrorTask",
+			Handler:    _Cluster_SearchDeleteErrorTask_Handler,
+		},
+		{
+			MethodName: "SearchUpdateTask",
+			Handler:    _Cluster_SearchUpdateTask_Handler,
+		},
+		{
+			MethodName: "SearchUpdateErrorTask",
+			Handler:    _Cluster_SearchUpdateErrorTask_Handler,
+		},
+		{
+			MethodName: "WebhookReg",
+			Handler:    _Cluster_WebhookReg_Handler,
+		},
+		{
+			MethodName: "WebhookUnReg",
+			Handler:    _Cluster_WebhookUnReg_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Cluster_Pause_Handler,
+		},
+		{
+			MethodName: "KindConfigSet",
+			Handler:    _Cluster_KindConfigSet_Handler,
+		},
+		{
+			MethodName: "InFlight",
+			Handler:    _Cluster_InFlight_Handler,
+		},
+		{
+			MethodName: "NamespaceCreate",
+			Handler:    _Cluster_NamespaceCreate_Handler,
+		},
+		{
+			MethodName: "NamespaceDrop",
+			Handler:    _Cluster_NamespaceDrop_Handler,
+		},
+		{
+			MethodName: "Namespaces",
+			Handler:    _Cluster_Namespaces_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Pool",
+			Handler:       _Cluster_Pool_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchTask",
+			Handler:       _Cluster_SearchTask_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchErrorTask",
+			Handler:       _Cluster_SearchErrorTask_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Events",
+			Handler:       _Cluster_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cluster/v1/cluster.proto",
+}
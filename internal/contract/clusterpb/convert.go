@@ -0,0 +1,229 @@
+package clusterpb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ChunkSize = 100
+)
+
+func TaskToProto(t contract.Task) *Task {
+	return &Task{
+		Id:     t.Id,
+		Kind:   t.Kind,
+		Group:  t.Group,
+		Owner:  t.Owner,
+		Status: int32(t.Status),
+		Param:  t.Param,
+		Ts:     timestamppb.New(t.Ts),
+		Error:  t.Error,
+	}
+}
+
+func TaskFromProto(t *Task) contract.Task {
+	return contract.Task{
+		Id:     t.GetId(),
+		Kind:   t.GetKind(),
+		Group:  t.GetGroup(),
+		Owner:  t.Owner,
+		Status: contract.Status(t.GetStatus()),
+		Param:  t.GetParam(),
+		Ts:     t.GetTs().AsTime(),
+		Error:  t.Error,
+	}
+}
+
+func TasksToChunks(tasks []contract.Task) (chunks []*TaskChunk) {
+	for start := 0; start < len(tasks); start += ChunkSize {
+		end := min(start+ChunkSize, len(tasks))
+		chunk := &TaskChunk{Tasks: make([]*Task, 0, end-start)}
+		for _, t := range tasks[start:end] {
+			chunk.Tasks = append(chunk.Tasks, TaskToProto(t))
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func TaskUpdateToProto(up contract.TaskUpdate) *TaskUpdate {
+	r := &TaskUpdate{
+		Kind:  up.Kind,
+		Group: up.Group,
+		Owner: up.Owner,
+		Param: up.Param,
+		Error: up.Error,
+	}
+	if up.Status != nil {
+		s := int32(*up.Status)
+		r.Status = &s
+	}
+	return r
+}
+
+func TaskUpdateFromProto(up *TaskUpdate) contract.TaskUpdate {
+	r := contract.TaskUpdate{
+		Kind:  up.Kind,
+		Group: up.Group,
+		Owner: up.Owner,
+		Param: up.GetParam(),
+		Error: up.Error,
+	}
+	if up.Status != nil {
+		s := contract.Status(*up.Status)
+		r.Status = &s
+	}
+	return r
+}
+
+func ConditionToProto(condition *contract.Condition) ([]byte, error) {
+	if condition == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(condition)
+	if err != nil {
+		return nil, fmt.Errorf("condition marshal error: %v", err)
+	}
+	return b, nil
+}
+
+func ConditionFromProto(b []byte) (*contract.Condition, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	condition := &contract.Condition{}
+	if err := json.Unmarshal(b, condition); err != nil {
+		return nil, fmt.Errorf("condition unmarshal error: %v", err)
+	}
+	return condition, nil
+}
+
+func SizeToProto(size *uint) *uint64 {
+	if size == nil {
+		return nil
+	}
+	s := uint64(*size)
+	return &s
+}
+
+func SizeFromProto(size *uint64) *uint {
+	if size == nil {
+		return nil
+	}
+	s := uint(*size)
+	return &s
+}
+
+func WebhookToProto(w contract.Webhook) *Webhook {
+	r := &Webhook{
+		Id:     w.Id,
+		Url:    w.Url,
+		Kinds:  w.Kinds,
+		Secret: w.Secret,
+	}
+	for _, s := range w.Statuses {
+		r.Statuses = append(r.Statuses, int32(s))
+	}
+	return r
+}
+
+func WebhookFromProto(w *Webhook) contract.Webhook {
+	r := contract.Webhook{
+		Id:     w.GetId(),
+		Url:    w.GetUrl(),
+		Kinds:  w.GetKinds(),
+		Secret: w.GetSecret(),
+	}
+	for _, s := range w.GetStatuses() {
+		r.Statuses = append(r.Statuses, contract.Status(s))
+	}
+	return r
+}
+
+func BatchItemsToProto(items []contract.BatchItem) *BatchResponse {
+	r := &BatchResponse{Items: make([]*BatchItem, 0, len(items))}
+	for _, it := range items {
+		r.Items = append(r.Items, &BatchItem{Id: it.Id, Error: it.Error})
+	}
+	return r
+}
+
+func BatchItemsFromProto(r *BatchResponse) []contract.BatchItem {
+	items := make([]contract.BatchItem, 0, len(r.GetItems()))
+	for _, it := range r.GetItems() {
+		items = append(items, contract.BatchItem{Id: it.GetId(), Error: it.Error})
+	}
+	return items
+}
+
+func KindConfigToProto(c contract.KindConfig) *KindConfig {
+	return &KindConfig{
+		Kind:        c.Kind,
+		Ordered:     c.Ordered,
+		MaxInFlight: int32(c.MaxInFlight),
+		Rate:        c.Rate,
+		Strategy:    string(c.Strategy),
+		HashParam:   c.HashParam,
+		Steal:       c.Steal,
+	}
+}
+
+func KindConfigFromProto(c *KindConfig) contract.KindConfig {
+	return contract.KindConfig{
+		Kind:        c.GetKind(),
+		Ordered:     c.GetOrdered(),
+		MaxInFlight: int(c.GetMaxInFlight()),
+		Rate:        c.GetRate(),
+		Strategy:    contract.Strategy(c.GetStrategy()),
+		HashParam:   c.GetHashParam(),
+		Steal:       c.GetSteal(),
+	}
+}
+
+func NamespaceToProto(ns contract.Namespace) *Namespace {
+	return &Namespace{
+		Name:     ns.Name,
+		MaxTasks: ns.MaxTasks,
+		MaxBytes: ns.MaxBytes,
+		Tasks:    ns.Usage.Tasks,
+		Bytes:    ns.Usage.Bytes,
+	}
+}
+
+func NamespaceFromProto(ns *Namespace) contract.Namespace {
+	return contract.Namespace{
+		Name:     ns.GetName(),
+		MaxTasks: ns.GetMaxTasks(),
+		MaxBytes: ns.GetMaxBytes(),
+		Usage:    contract.NamespaceUsage{Tasks: ns.GetTasks(), Bytes: ns.GetBytes()},
+	}
+}
+
+func TaskEventToProto(e contract.TaskEvent) *TaskEvent {
+	r := &TaskEvent{
+		Index:  e.Index,
+		Offset: int64(e.Offset),
+		Type:   string(e.Type),
+	}
+	if e.Task != nil {
+		r.Task = TaskToProto(*e.Task)
+	}
+	return r
+}
+
+func TaskEventFromProto(e *TaskEvent) contract.TaskEvent {
+	r := contract.TaskEvent{
+		Index:  e.GetIndex(),
+		Offset: int(e.GetOffset()),
+		Type:   contract.TaskEventType(e.GetType()),
+	}
+	if e.Task != nil {
+		t := TaskFromProto(e.Task)
+		r.Task = &t
+	}
+	return r
+}
@@ -0,0 +1,76 @@
+package clusterpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestConvert_Task(t *testing.T) {
+	owner := "100"
+	errorTxt := "error test"
+	task := contract.Task{
+		Id:     "t-TEST-00Q0P8XD40001",
+		Kind:   "TEST",
+		Group:  "12345",
+		Owner:  &owner,
+		Status: contract.SCHEDULED,
+		Param:  map[string]string{"pid": "12345"},
+		Ts:     time.Now().UTC(),
+		Error:  &errorTxt,
+	}
+
+	out := TaskFromProto(TaskToProto(task))
+	if out.Id != task.Id || out.Kind != task.Kind || out.Group != task.Group || out.Status != task.Status {
+		t.Errorf("not correct convert task %+v", out)
+	}
+	if *out.Owner != owner || *out.Error != errorTxt || out.Param["pid"] != "12345" {
+		t.Errorf("not correct convert task optional fields %+v", out)
+	}
+	if !out.Ts.Equal(task.Ts) {
+		t.Errorf("not correct convert task ts %v", out.Ts)
+	}
+}
+
+func TestConvert_TasksToChunks(t *testing.T) {
+	tasks := make([]contract.Task, ChunkSize*2+1)
+
+	chunks := TasksToChunks(tasks)
+	if len(chunks) != 3 {
+		t.Fatalf("not correct count chunks %d", len(chunks))
+	}
+	if len(chunks[2].Tasks) != 1 {
+		t.Errorf("not correct last chunk size %d", len(chunks[2].Tasks))
+	}
+	if len(TasksToChunks(nil)) != 0 {
+		t.Errorf("not correct chunks of empty tasks")
+	}
+}
+
+func TestConvert_Condition(t *testing.T) {
+	operator := contract.And
+	condition := &contract.Condition{
+		LogicalOperator: &operator,
+		Operations: []contract.Operation{
+			{Field: "kind", Value: "TEST", Operator: contract.Equal},
+		},
+	}
+
+	b, err := ConditionToProto(condition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ConditionFromProto(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *out.LogicalOperator != operator || out.Operations[0].Value != "TEST" {
+		t.Errorf("not correct convert condition %+v", out)
+	}
+
+	out, err = ConditionFromProto(nil)
+	if err != nil || out != nil {
+		t.Errorf("not correct convert empty condition")
+	}
+}
@@ -0,0 +1,104 @@
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrSlowSubscriber ends the events of a client that did not keep up.
+var ErrSlowSubscriber = errors.New("events subscriber is too slow")
+
+type TaskEventType string
+
+const (
+	TaskPut      TaskEventType = "task"
+	TaskDeleted  TaskEventType = "task_deleted"
+	ErrorPut     TaskEventType = "error"
+	ErrorDeleted TaskEventType = "error_deleted"
+	// the requested index is no longer buffered, the client has to resync
+	EventsReset TaskEventType = "reset"
+	// the stream sends the events after Resume
+	EventsStart TaskEventType = "start"
+)
+
+// TaskEvent is a task change applied by the raft log entry Index, Offset
+// orders the events of one entry.
+type TaskEvent struct {
+	Index  uint64        `json:"i"`
+	Offset int           `json:"o,omitzero"`
+	Type   TaskEventType `json:"y"`
+	Task   *Task         `json:"t,omitzero"`
+	// Resume is the cursor of the cluster stream after the event
+	Resume string `json:"r,omitzero"`
+}
+
+func (e TaskEvent) Cursor() EventCursor {
+	return EventCursor{Index: e.Index, Offset: e.Offset}
+}
+
+// EventCursor is the position of a task event to resume after, "index.offset".
+// A bare index stands for the end of its entry.
+type EventCursor struct {
+	Index  uint64
+	Offset int
+}
+
+func ParseEventCursor(s string) (c EventCursor, err error) {
+	index, offset, hasOffset := strings.Cut(s, ".")
+	if c.Index, err = strconv.ParseUint(index, 10, 64); err != nil {
+		return c, fmt.Errorf("bad event cursor %q", s)
+	}
+	if !hasOffset {
+		c.Offset = math.MaxInt
+		return c, nil
+	}
+	if c.Offset, err = strconv.Atoi(offset); err != nil || c.Offset < 0 {
+		return c, fmt.Errorf("bad event cursor %q", s)
+	}
+	return c, nil
+}
+
+func (c EventCursor) String() string {
+	if c.Offset == math.MaxInt {
+		return strconv.FormatUint(c.Index, 10)
+	}
+	return fmt.Sprintf("%d.%d", c.Index, c.Offset)
+}
+
+// Before reports whether c is earlier in the raft log than o.
+func (c EventCursor) Before(o EventCursor) bool {
+	return c.Index < o.Index || c.Index == o.Index && c.Offset < o.Offset
+}
+
+// StreamCursor is the position of an events stream of the cluster, the
+// cursor of every node in the order of the cluster nodes, "3.1,5". Each node
+// has its own raft log, so a stream resumes only on the cluster it came from.
+type StreamCursor []EventCursor
+
+func ParseStreamCursor(s string, nodes int) (c StreamCursor, err error) {
+	c = make(StreamCursor, nodes)
+	if s == "" {
+		return c, nil
+	}
+	its := strings.Split(s, ",")
+	if len(its) != nodes {
+		return nil, fmt.Errorf("event cursor %q is not of a cluster of %d nodes", s, nodes)
+	}
+	for i, it := range its {
+		if c[i], err = ParseEventCursor(it); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c StreamCursor) String() string {
+	its := make([]string, len(c))
+	for i, cursor := range c {
+		its[i] = cursor.String()
+	}
+	return strings.Join(its, ",")
+}
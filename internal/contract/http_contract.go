@@ -23,14 +23,106 @@ type UpdateRequest struct {
 	Error  *string           `json:"e"`
 }
 
+type BatchAddRequest struct {
+	Tasks []AddRequest `json:"t"`
+}
+
+type BatchUpdateRequest struct {
+	Tasks []UpdateRequest `json:"t"`
+}
+
+// BatchItem is the outcome of one item, in the order of the request.
+type BatchItem struct {
+	Id    string  `json:"id,omitzero"`
+	Error *string `json:"e,omitzero"`
+}
+
+type BatchResponse struct {
+	Items []BatchItem `json:"r"`
+}
+
 type OwnerRegRequest struct {
-	Owner    string   `json:"o"`
-	Kinds    []string `json:"k"`
-	Internal bool     `json:"i"`
+	Owner string   `json:"o"`
+	Kinds []string `json:"k"`
+	// ttl in milliseconds, the owner expires unless registered
+	// again or sends a heartbeat in time, 0 never expires
+	Ttl int64 `json:"ttl,omitzero"`
+	// share of the tasks the owner gets relative to the other owners
+	// of a kind, 0 is 1
+	Weight   int  `json:"w,omitzero"`
+	Internal bool `json:"i"`
+}
+
+type OwnerHeartbeatRequest struct {
+	Owner string `json:"o"`
+	// ttl in milliseconds
+	Ttl      int64 `json:"ttl"`
+	Internal bool  `json:"i"`
+}
+
+type PauseRequest struct {
+	Kind     string `json:"k"`
+	Group    string `json:"g"`
+	Internal bool   `json:"i"`
+}
+
+type KindConfigRequest struct {
+	KindConfig
+	Internal bool `json:"i"`
+}
+
+type InFlightResponse struct {
+	Count int `json:"n"`
+}
+
+type OwnerKinds struct {
+	Owner string   `json:"o"`
+	Kinds []string `json:"k"`
+}
+
+type KindOwners struct {
+	Kind   string   `json:"k"`
+	Owners []string `json:"o"`
+	// weight of each owner
+	Weights  []int    `json:"w"`
+	Strategy Strategy `json:"s,omitempty"`
+}
+
+type RaftStatus struct {
+	State        string `json:"s"`
+	Leader       string `json:"l"`
+	Term         uint64 `json:"t"`
+	LastIndex    uint64 `json:"li"`
+	AppliedIndex uint64 `json:"ai"`
+	Peers        int    `json:"p"`
+}
+
+type StatusResponse struct {
+	Node  string      `json:"n"`
+	Nodes []string    `json:"ns"`
+	Raft  *RaftStatus `json:"r,omitzero"`
 }
 
 type OwnerUnRegRequest struct {
-	Owner    string `json:"o"`
+	Owner string `json:"o"`
+	// empty unregisters every kind of the owner
+	Kind string `json:"k,omitempty"`
+	// hands the tasks of the owner over to the other owners of the kind
+	Handoff  bool `json:"h,omitzero"`
+	Internal bool `json:"i"`
+}
+
+type WebhookRegRequest struct {
+	Webhook
+	Internal bool `json:"i"`
+}
+
+type WebhookRegResponse struct {
+	Id string `json:"id"`
+}
+
+type WebhookUnRegRequest struct {
+	Id       string `json:"id"`
 	Internal bool   `json:"i"`
 }
 
@@ -39,10 +131,11 @@ type GetFirstInGroupResponse struct {
 }
 
 type SearchTaskRequest struct {
-	Condition *Condition `json:"c"`
-	Kind      *string    `json:"k"`
-	Size      *uint      `json:"s"`
-	Internal  bool       `json:"i"`
+	Condition    *Condition `json:"c"`
+	Kind         *string    `json:"k"`
+	Size         *uint      `json:"s"`
+	Internal     bool       `json:"i"`
+	AllowPartial bool       `json:"ap"`
 }
 
 type SearchUpdateTaskRequest struct {
@@ -52,3 +145,27 @@ type SearchUpdateTaskRequest struct {
 	Size      *uint      `json:"s"`
 	Internal  bool       `json:"i"`
 }
+
+type NodeState string
+
+const (
+	NodeOk      NodeState = "ok"
+	NodeTimeout NodeState = "timeout"
+	NodeError   NodeState = "error"
+)
+
+type NodeStatus struct {
+	Node  string    `json:"n"`
+	State NodeState `json:"s"`
+	Error *string   `json:"e,omitzero"`
+}
+
+type PartialTasksResponse struct {
+	Tasks []Task       `json:"t"`
+	Nodes []NodeStatus `json:"n"`
+}
+
+type NamespaceRequest struct {
+	Namespace
+	Internal bool `json:"i"`
+}
@@ -0,0 +1,42 @@
+package contract
+
+// KindConfig changes how the tasks of a kind are pooled.
+type KindConfig struct {
+	Kind string `json:"k"`
+	// only the head task of each group is pooled, the next one
+	// after the head is completed or moved to errors
+	Ordered bool `json:"o,omitzero"`
+	// max count of SCHEDULED tasks in the cluster, 0 is unlimited
+	MaxInFlight int `json:"mi,omitzero"`
+	// max count of tasks scheduled per second, 0 is unlimited
+	Rate float64 `json:"r,omitzero"`
+	// how new tasks get their owner, empty is ROUND_ROBIN
+	Strategy Strategy `json:"s,omitempty"`
+	// param of the task HASH assigns by, tasks without it go round robin
+	HashParam string `json:"hp,omitempty"`
+	// an owner with an empty pool takes VIRGIN tasks of the owner with the
+	// most of them, the pool marks the tasks it hands out SCHEDULED
+	Steal bool `json:"sl,omitzero"`
+}
+
+type Strategy string
+
+const (
+	ROUND_ROBIN Strategy = "round_robin"
+	// round robin in proportion to the owner weights
+	WEIGHTED Strategy = "weighted"
+	// the owner with the fewest VIRGIN and SCHEDULED tasks per weight
+	LEAST_OUTSTANDING Strategy = "least_outstanding"
+	// the same owner for the same value of the hash param
+	HASH Strategy = "hash"
+	// tasks are added without an owner
+	UNASSIGNED Strategy = "unassigned"
+)
+
+func (s Strategy) Valid() bool {
+	switch s {
+	case "", ROUND_ROBIN, WEIGHTED, LEAST_OUTSTANDING, HASH, UNASSIGNED:
+		return true
+	}
+	return false
+}
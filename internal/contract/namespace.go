@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// NamespaceHeader selects the namespace of a request, empty is the default one.
+const NamespaceHeader = "X-Namespace"
+
+var ErrNamespaceNotFound = errors.New("namespace not found")
+
+// Namespace isolates the tasks, owners and configs of a team, its quotas
+// limit the whole cluster, 0 is unlimited.
+type Namespace struct {
+	Name     string `json:"n"`
+	MaxTasks int64  `json:"mt,omitzero"`
+	MaxBytes int64  `json:"mb,omitzero"`
+	// of the listing node or of the cluster
+	Usage NamespaceUsage `json:"u,omitzero"`
+}
+
+// NamespaceUsage counts the tasks and error tasks of a namespace and the
+// bytes of all its keys and values.
+type NamespaceUsage struct {
+	Tasks int64 `json:"t"`
+	Bytes int64 `json:"b"`
+}
+
+// QuotaError rejects a task the namespace has no room for.
+type QuotaError struct {
+	Msg string
+}
+
+func (e QuotaError) Error() string {
+	return e.Msg
+}
+
+var namespaceName = regexp.MustCompile(`^[A-Za-z0-9_]{1,64}$`)
+
+// ValidNamespace reports whether name can be a namespace, it is part of the keys.
+func ValidNamespace(name string) bool {
+	return namespaceName.MatchString(name)
+}
+
+type namespaceKey struct{}
+
+func WithNamespace(ctx context.Context, ns string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, ns)
+}
+
+// NamespaceFrom returns the namespace of a request, empty is the default one.
+func NamespaceFrom(ctx context.Context) string {
+	ns, _ := ctx.Value(namespaceKey{}).(string)
+	return ns
+}
@@ -0,0 +1,9 @@
+package contract
+
+import "time"
+
+// OwnerBeat is the liveness of an owner that registered with a ttl.
+type OwnerBeat struct {
+	Owner   string    `json:"o"`
+	Expires time.Time `json:"ex"`
+}
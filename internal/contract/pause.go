@@ -0,0 +1,10 @@
+package contract
+
+import "time"
+
+// Pause keeps the tasks of a kind or a group out of the pool until resumed.
+type Pause struct {
+	Kind  string    `json:"k,omitzero"`
+	Group string    `json:"g,omitzero"`
+	Ts    time.Time `json:"t"`
+}
@@ -5,6 +5,12 @@ type EventType string
 const (
 	SetType    EventType = "set"
 	DeleteType EventType = "del"
+	// HandoffType gives the task Key to the new owner of the TaskHandoff in
+	// Value, only while the task still is of the leaving owner when applied.
+	HandoffType EventType = "handoff"
+	// RewindType moves the offset Key back to Value, only when it is set past
+	// Value when applied.
+	RewindType EventType = "rewind"
 )
 
 type Event struct {
@@ -12,3 +18,10 @@ type Event struct {
 	Key   []byte
 	Value []byte
 }
+
+// TaskHandoff is the value of a HandoffType event, a nil To leaves the task
+// without an owner.
+type TaskHandoff struct {
+	From string  `json:"f"`
+	To   *string `json:"t,omitempty"`
+}
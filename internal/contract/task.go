@@ -20,6 +20,8 @@ const (
 	SCHEDULED Status = 2
 	COMPLETED Status = 3
 	FAILED    Status = 4
+	CANCELLED Status = 5
+	PAUSED    Status = 6
 )
 
 type TaskUpdate struct {
@@ -30,3 +32,13 @@ type TaskUpdate struct {
 	Param  map[string]string `json:"p"`
 	Error  *string           `json:"e,omitzero"`
 }
+
+// TaskCount is the count of tasks of a kind in a status on a node, of error
+// tasks when Error is set.
+type TaskCount struct {
+	Namespace string
+	Kind      string
+	Status    Status
+	Error     bool
+	Count     int64
+}
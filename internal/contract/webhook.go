@@ -0,0 +1,30 @@
+package contract
+
+import "time"
+
+type Webhook struct {
+	Id    string   `json:"id"`
+	Url   string   `json:"u"`
+	Kinds []string `json:"k"`
+	// COMPLETED and FAILED when empty, CANCELLED only when listed
+	Statuses []Status `json:"s"`
+	Secret   string   `json:"sc,omitzero"`
+}
+
+// WebhookDelivery is an outbox entry, it stays in the store until delivered.
+type WebhookDelivery struct {
+	Id      string    `json:"id"`
+	Webhook string    `json:"w"`
+	Status  Status    `json:"s"`
+	Task    Task      `json:"t"`
+	Attempt int       `json:"a"`
+	Next    time.Time `json:"n"`
+}
+
+// WebhookEvent is the signed body posted to a webhook url.
+type WebhookEvent struct {
+	Id      string `json:"id"`
+	Webhook string `json:"w"`
+	Status  Status `json:"s"`
+	Task    Task   `json:"t"`
+}
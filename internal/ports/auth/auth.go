@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+)
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Authenticator resolves the bearer token of a request to its caller.
+type Authenticator interface {
+	Authenticate(token string) (access.Principal, error)
+}
+
+// BearerToken extracts the token of an Authorization header value.
+func BearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// Chain tries every authenticator in order, the first that accepts the token wins.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(token string) (access.Principal, error) {
+	for _, a := range c {
+		if p, err := a.Authenticate(token); err == nil {
+			return p, nil
+		}
+	}
+	return access.Principal{}, ErrUnauthenticated
+}
+
+// Keys accepts static api keys.
+type Keys struct {
+	keys map[string]string
+}
+
+// NewKeys takes api keys as name:key, a key without a name is named apikey.
+func NewKeys(keys []string) (Keys, error) {
+	k := Keys{keys: make(map[string]string, len(keys))}
+	for _, it := range keys {
+		name, key, ok := strings.Cut(it, ":")
+		if !ok {
+			name, key = "apikey", it
+		}
+		if key == "" {
+			return k, fmt.Errorf("empty api key of %v", name)
+		}
+		k.keys[key] = name
+	}
+	return k, nil
+}
+
+func (k Keys) Authenticate(token string) (access.Principal, error) {
+	for key, name := range k.keys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(token)) == 1 {
+			return access.Principal{Subject: name}, nil
+		}
+	}
+	return access.Principal{}, ErrUnauthenticated
+}
+
+// ClusterToken accepts the credential nodes call each other with.
+type ClusterToken string
+
+func (c ClusterToken) Authenticate(token string) (access.Principal, error) {
+	if c == "" || subtle.ConstantTimeCompare([]byte(c), []byte(token)) != 1 {
+		return access.Principal{}, ErrUnauthenticated
+	}
+	return access.Principal{Subject: "cluster", Cluster: true}, nil
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+)
+
+func sign(secret string, header string, claims string) string {
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString([]byte(header)) + "." + enc.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+func TestJwt_Authenticate(t *testing.T) {
+	j, err := NewJwt("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	j.now = func() time.Time { return time.Unix(1000, 0) }
+	hs256 := `{"alg":"HS256","typ":"JWT"}`
+
+	p, err := j.Authenticate(sign("secret", hs256, `{"sub":"alice","roles":["ops"],"exp":2000,"nbf":500}`))
+	if err != nil || p.Subject != "alice" || p.Cluster || len(p.Roles) != 1 || p.Roles[0] != "ops" {
+		t.Errorf("not correct principal %+v %v", p, err)
+	}
+
+	for name, token := range map[string]string{
+		"other secret": sign("other", hs256, `{"sub":"alice"}`),
+		"expired":      sign("secret", hs256, `{"sub":"alice","exp":1000}`),
+		"not before":   sign("secret", hs256, `{"sub":"alice","nbf":1001}`),
+		"no subject":   sign("secret", hs256, `{"exp":2000}`),
+		"alg none":     sign("secret", `{"alg":"none"}`, `{"sub":"alice"}`),
+		"malformed":    "a.b",
+	} {
+		if _, err := j.Authenticate(token); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("not correct %v token accepted %v", name, err)
+		}
+	}
+}
+
+func TestChain_Authenticate(t *testing.T) {
+	keys, err := NewKeys([]string{"ci:k1", "k2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := Chain{keys, ClusterToken("node")}
+
+	for token, want := range map[string]access.Principal{
+		"k1":   {Subject: "ci"},
+		"k2":   {Subject: "apikey"},
+		"node": {Subject: "cluster", Cluster: true},
+	} {
+		if p, err := chain.Authenticate(token); err != nil || p.Subject != want.Subject || p.Cluster != want.Cluster {
+			t.Errorf("not correct principal of %v %+v %v", token, p, err)
+		}
+	}
+	if _, err := chain.Authenticate("k3"); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("not correct unknown key accepted %v", err)
+	}
+	if _, err := ClusterToken("").Authenticate(""); err == nil {
+		t.Errorf("not correct empty cluster token accepted")
+	}
+	if _, err := NewKeys([]string{"ci:"}); err == nil {
+		t.Errorf("not correct empty key accepted")
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	if token, ok := BearerToken("bearer abc"); !ok || token != "abc" {
+		t.Errorf("not correct token %v %v", token, ok)
+	}
+	if _, ok := BearerToken("Basic abc"); ok {
+		t.Errorf("not correct basic accepted")
+	}
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+)
+
+// Jwt accepts bearer tokens signed with HS256, HS384 or HS512 by a shared secret,
+// the claim roles gives roles of the access policy.
+type Jwt struct {
+	secret []byte
+	now    func() time.Time
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Sub   string   `json:"sub"`
+	Roles []string `json:"roles"`
+	Exp   *int64   `json:"exp"`
+	Nbf   *int64   `json:"nbf"`
+}
+
+func NewJwt(secret string) (Jwt, error) {
+	if secret == "" {
+		return Jwt{}, errors.New("empty jwt secret")
+	}
+	return Jwt{secret: []byte(secret), now: time.Now}, nil
+}
+
+func (j Jwt) Authenticate(token string) (access.Principal, error) {
+	claims, err := j.verify(token)
+	if err != nil {
+		return access.Principal{}, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+	}
+	if claims.Sub == "" {
+		return access.Principal{}, fmt.Errorf("%w: jwt without sub", ErrUnauthenticated)
+	}
+	return access.Principal{Subject: claims.Sub, Roles: claims.Roles}, nil
+}
+
+func (j Jwt) verify(token string) (claims jwtClaims, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims, errors.New("malformed jwt")
+	}
+
+	var header jwtHeader
+	if err = decodeSegment(parts[0], &header); err != nil {
+		return claims, err
+	}
+	var h func() hash.Hash
+	switch header.Alg {
+	case "HS256":
+		h = sha256.New
+	case "HS384":
+		h = sha512.New384
+	case "HS512":
+		h = sha512.New
+	default:
+		return claims, fmt.Errorf("unsupported jwt alg %q", header.Alg)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims, fmt.Errorf("jwt signature error: %v", err)
+	}
+	mac := hmac.New(h, j.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return claims, errors.New("invalid jwt signature")
+	}
+
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return claims, err
+	}
+	now := j.now().Unix()
+	if claims.Exp != nil && now >= *claims.Exp {
+		return claims, errors.New("jwt expired")
+	}
+	if claims.Nbf != nil && now < *claims.Nbf {
+		return claims, errors.New("jwt not valid yet")
+	}
+	return claims, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("jwt segment error: %v", err)
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("jwt segment format error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/app"
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/contract/clusterpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ClusterServer struct {
+	clusterpb.UnimplementedClusterServer
+	apps *app.Namespaces
+}
+
+func NewClusterServer(apps *app.Namespaces) *ClusterServer {
+	return &ClusterServer{apps: apps}
+}
+
+// app is the application of the namespace of the call.
+func (s *ClusterServer) app(ctx context.Context) app.Application {
+	return application(ctx, s.apps)
+}
+
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+	if errors.Is(err, access.ErrForbidden) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, contract.ErrNamespaceNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.As(err, &contract.QuotaError{}) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, contract.ErrSlowSubscriber) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func sendTasks(stream grpc.ServerStreamingServer[clusterpb.TaskChunk], tasks []contract.Task) error {
+	for _, chunk := range clusterpb.TasksToChunks(tasks) {
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ClusterServer) Add(ctx context.Context, r *clusterpb.AddRequest) (*clusterpb.AddResponse, error) {
+	id, err := s.app(ctx).Commands.AddTask.Handle(ctx, r.GetGroup(), r.GetKind(), r.Owner, r.GetParam())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &clusterpb.AddResponse{Id: id}, nil
+}
+
+func (s *ClusterServer) Update(ctx context.Context, r *clusterpb.UpdateRequest) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.UpdateTask.Handle(
+		ctx,
+		r.GetGroup(),
+		r.GetId(),
+		contract.Status(r.GetStatus()),
+		r.GetParam(),
+		r.Error,
+	)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) AddBatch(ctx context.Context, r *clusterpb.AddBatchRequest) (*clusterpb.BatchResponse, error) {
+	tasks := make([]contract.AddRequest, 0, len(r.GetTasks()))
+	for _, t := range r.GetTasks() {
+		tasks = append(tasks, contract.AddRequest{
+			Group: t.GetGroup(),
+			Kind:  t.GetKind(),
+			Owner: t.Owner,
+			Param: t.GetParam(),
+		})
+	}
+	items, err := s.app(ctx).Commands.AddTaskBatch.Handle(ctx, tasks)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return clusterpb.BatchItemsToProto(items), nil
+}
+
+func (s *ClusterServer) UpdateBatch(ctx context.Context, r *clusterpb.UpdateBatchRequest) (*clusterpb.BatchResponse, error) {
+	tasks := make([]contract.UpdateRequest, 0, len(r.GetTasks()))
+	for _, t := range r.GetTasks() {
+		tasks = append(tasks, contract.UpdateRequest{
+			Id:     t.GetId(),
+			Group:  t.GetGroup(),
+			Status: int(t.GetStatus()),
+			Param:  t.GetParam(),
+			Error:  t.Error,
+		})
+	}
+	items, err := s.app(ctx).Commands.UpdateTaskBatch.Handle(ctx, tasks)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return clusterpb.BatchItemsToProto(items), nil
+}
+
+func (s *ClusterServer) Get(ctx context.Context, r *clusterpb.GetRequest) (*clusterpb.GetResponse, error) {
+	task, err := s.app(ctx).Queries.Get.Handle(ctx, r.GetGroup(), r.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &clusterpb.GetResponse{}
+	if task != nil {
+		res.Task = clusterpb.TaskToProto(*task)
+	}
+	return res, nil
+}
+
+func (s *ClusterServer) GetFirstInGroup(ctx context.Context, r *clusterpb.GetFirstInGroupRequest) (*clusterpb.GetFirstInGroupResponse, error) {
+	id, err := s.app(ctx).Queries.GetFirstInGroup.Handle(ctx, r.GetGroup())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &clusterpb.GetFirstInGroupResponse{Id: id}, nil
+}
+
+func (s *ClusterServer) OwnerReg(ctx context.Context, r *clusterpb.OwnerRegRequest) (*clusterpb.Empty, error) {
+	ttl := time.Duration(r.GetTtlMs()) * time.Millisecond
+	err := s.app(ctx).Commands.OwnerReg.Handle(ctx, r.GetOwner(), r.GetKinds(), ttl, int(r.GetWeight()), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) OwnerHeartbeat(ctx context.Context, r *clusterpb.OwnerHeartbeatRequest) (*clusterpb.Empty, error) {
+	ttl := time.Duration(r.GetTtlMs()) * time.Millisecond
+	err := s.app(ctx).Commands.OwnerHeartbeat.Handle(ctx, r.GetOwner(), ttl, true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) OwnerUnReg(ctx context.Context, r *clusterpb.OwnerUnRegRequest) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.OwnerUnReg.Handle(ctx, r.GetOwner(), r.GetKind(), r.GetHandoff(), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) Pool(r *clusterpb.PoolRequest, stream grpc.ServerStreamingServer[clusterpb.TaskChunk]) error {
+	wait := time.Duration(r.GetWaitMs()) * time.Millisecond
+	var tasks []contract.Task
+	var err error
+	if r.GetLease() {
+		tasks, _, err = s.app(stream.Context()).Queries.Pool.Lease(stream.Context(), r.GetOwner(), r.GetKind(), false, wait)
+	} else {
+		tasks, _, err = s.app(stream.Context()).Queries.Pool.Handle(stream.Context(), r.GetOwner(), r.GetKind(), true, false, wait)
+	}
+	if err != nil {
+		return toStatus(err)
+	}
+	return sendTasks(stream, tasks)
+}
+
+func (s *ClusterServer) SearchTask(r *clusterpb.SearchRequest, stream grpc.ServerStreamingServer[clusterpb.TaskChunk]) error {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	// the tasks are sent while they are read
+	err = s.app(stream.Context()).Queries.SearchTask.Chunks(
+		stream.Context(),
+		condition,
+		r.Kind,
+		clusterpb.SizeFromProto(r.Size),
+		func(tasks []contract.Task) error { return sendTasks(stream, tasks) },
+	)
+	return toStatus(err)
+}
+
+func (s *ClusterServer) SearchErrorTask(r *clusterpb.SearchRequest, stream grpc.ServerStreamingServer[clusterpb.TaskChunk]) error {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	// the tasks are sent while they are read
+	err = s.app(stream.Context()).Queries.SearchError.Chunks(
+		stream.Context(),
+		condition,
+		r.Kind,
+		clusterpb.SizeFromProto(r.Size),
+		func(tasks []contract.Task) error { return sendTasks(stream, tasks) },
+	)
+	return toStatus(err)
+}
+
+func (s *ClusterServer) Events(r *clusterpb.EventsRequest, stream grpc.ServerStreamingServer[clusterpb.TaskEvent]) error {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	from := contract.EventCursor{Index: r.GetIndex(), Offset: int(r.GetOffset())}
+	err = s.app(stream.Context()).Queries.Events.Node(
+		stream.Context(),
+		condition,
+		from,
+		func(e contract.TaskEvent) error { return stream.Send(clusterpb.TaskEventToProto(e)) },
+	)
+	return toStatus(err)
+}
+
+func (s *ClusterServer) SearchDeleteTask(ctx context.Context, r *clusterpb.SearchRequest) (*clusterpb.Empty, error) {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchDeleteTask.Handle(ctx, condition, r.Kind, clusterpb.SizeFromProto(r.Size), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) SearchDeleteErrorTask(ctx context.Context, r *clusterpb.SearchRequest) (*clusterpb.Empty, error) {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchDeleteErrorTask.Handle(ctx, condition, r.Kind, clusterpb.SizeFromProto(r.Size), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) SearchUpdateTask(ctx context.Context, r *clusterpb.SearchUpdateRequest) (*clusterpb.Empty, error) {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchUpdateTask.Handle(
+		ctx,
+		clusterpb.TaskUpdateFromProto(r.GetUp()),
+		condition,
+		r.Kind,
+		clusterpb.SizeFromProto(r.Size),
+		true,
+	)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) SearchUpdateErrorTask(ctx context.Context, r *clusterpb.SearchUpdateRequest) (*clusterpb.Empty, error) {
+	condition, err := clusterpb.ConditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchUpdateErrorTask.Handle(
+		ctx,
+		clusterpb.TaskUpdateFromProto(r.GetUp()),
+		condition,
+		r.Kind,
+		clusterpb.SizeFromProto(r.Size),
+		true,
+	)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) WebhookReg(ctx context.Context, r *clusterpb.Webhook) (*clusterpb.Empty, error) {
+	_, err := s.app(ctx).Commands.WebhookReg.Handle(ctx, clusterpb.WebhookFromProto(r), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) WebhookUnReg(ctx context.Context, r *clusterpb.WebhookUnRegRequest) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.WebhookUnReg.Handle(ctx, r.GetId(), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) Pause(ctx context.Context, r *clusterpb.PauseRequest) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.Pause.Handle(ctx, r.GetKind(), r.GetGroup(), r.GetPaused(), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) KindConfigSet(ctx context.Context, r *clusterpb.KindConfig) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.KindConfig.Handle(ctx, clusterpb.KindConfigFromProto(r), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) InFlight(ctx context.Context, r *clusterpb.InFlightRequest) (*clusterpb.InFlightResponse, error) {
+	n, err := s.app(ctx).Queries.InFlight.Handle(ctx, r.GetKind(), true)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &clusterpb.InFlightResponse{Count: int64(n)}, nil
+}
+
+func (s *ClusterServer) NamespaceCreate(ctx context.Context, r *clusterpb.Namespace) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.NamespaceCreate.Handle(ctx, clusterpb.NamespaceFromProto(r), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) NamespaceDrop(ctx context.Context, r *clusterpb.NamespaceDropRequest) (*clusterpb.Empty, error) {
+	err := s.app(ctx).Commands.NamespaceDrop.Handle(ctx, r.GetName(), true)
+	return &clusterpb.Empty{}, toStatus(err)
+}
+
+func (s *ClusterServer) Namespaces(ctx context.Context, r *clusterpb.Empty) (*clusterpb.NamespacesResponse, error) {
+	namespaces, err := s.app(ctx).Queries.Namespaces.Handle(ctx, true)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &clusterpb.NamespacesResponse{}
+	for _, ns := range namespaces {
+		res.Namespaces = append(res.Namespaces, clusterpb.NamespaceToProto(ns))
+	}
+	return res, nil
+}
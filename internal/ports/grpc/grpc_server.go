@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type GrpcServer struct {
+	port   string
+	server *grpc.Server
+	health *health.Server
+	logger *log.Logger
+	done   chan struct{}
+}
+
+func NewGrpcServer(port string, logger *log.Logger, opts ...grpc.ServerOption) GrpcServer {
+	g := GrpcServer{
+		port:   port,
+		server: grpc.NewServer(opts...),
+		health: health.NewServer(),
+		logger: logger,
+		done:   make(chan struct{}),
+	}
+	healthpb.RegisterHealthServer(g.server, g.health)
+	return g
+}
+
+func (g GrpcServer) Register(desc *grpc.ServiceDesc, impl any) {
+	g.server.RegisterService(desc, impl)
+}
+
+func (g GrpcServer) Start() error {
+	lis, err := net.Listen("tcp", ":"+g.port)
+	if err != nil {
+		return fmt.Errorf("could not listen on port %v: %v", g.port, err)
+	}
+
+	g.logger.Printf("Grpc server starting at port %v ...", g.port)
+	if err := g.server.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+		return fmt.Errorf("grpc server on port %v error: %v", g.port, err)
+	}
+	return nil
+}
+
+func (g GrpcServer) Stop() {
+	close(g.done)
+	g.health.Shutdown()
+	g.server.GracefulStop()
+	g.logger.Printf("Grpc server at port %v stopped", g.port)
+}
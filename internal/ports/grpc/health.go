@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// WatchHealth keeps the standard grpc health service in line with check
+// until the server is stopped.
+func (g GrpcServer) WatchHealth(check func(ctx context.Context) error, interval time.Duration) {
+	update := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		defer cancel()
+		if err := check(ctx); err != nil {
+			g.logger.Printf("Grpc health check failed: %v", err)
+			g.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+			return
+		}
+		g.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		update()
+		for {
+			select {
+			case <-g.done:
+				return
+			case <-ticker.C:
+				update()
+			}
+		}
+	}()
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/internal/app"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// namespaceKey is the metadata of the namespace of a call, empty is the default one.
+const namespaceKey = "x-namespace"
+
+type appKey struct{}
+
+// Namespace serves every call by the application of the namespace of its
+// metadata, a call of an unknown namespace is not found.
+func Namespace(apps *app.Namespaces) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			ctx, err := namespace(ctx, apps, info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := namespace(ss.Context(), apps, info.FullMethod)
+			if err != nil {
+				return err
+			}
+			return handler(srv, contextStream{ServerStream: ss, ctx: ctx})
+		}),
+	}
+}
+
+func namespace(ctx context.Context, apps *app.Namespaces, method string) (context.Context, error) {
+	if strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var ns string
+	if values := md.Get(namespaceKey); len(values) > 0 {
+		ns = values[0]
+	}
+	a, err := apps.Application(ns)
+	if err != nil {
+		return ctx, toStatus(err)
+	}
+	return context.WithValue(contract.WithNamespace(ctx, ns), appKey{}, a), nil
+}
+
+// application is the application of the namespace of ctx, the default one
+// without the interceptor.
+func application(ctx context.Context, apps *app.Namespaces) app.Application {
+	if a, ok := ctx.Value(appKey{}).(app.Application); ok {
+		return a
+	}
+	return apps.Default()
+}
@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	pb "github.com/esaseleznev/taskstoredb/pkg/taskstorepb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func taskToProto(t contract.Task) *pb.Task {
+	return &pb.Task{
+		Id:     t.Id,
+		Kind:   t.Kind,
+		Group:  t.Group,
+		Owner:  t.Owner,
+		Status: pb.Status(t.Status),
+		Param:  t.Param,
+		Ts:     timestamppb.New(t.Ts),
+		Error:  t.Error,
+	}
+}
+
+func pauseToProto(p contract.Pause) *pb.Pause {
+	return &pb.Pause{
+		Kind:  p.Kind,
+		Group: p.Group,
+		Ts:    timestamppb.New(p.Ts),
+	}
+}
+
+func kindConfigToProto(c contract.KindConfig) *pb.KindConfig {
+	return &pb.KindConfig{
+		Kind:        c.Kind,
+		Ordered:     c.Ordered,
+		MaxInFlight: int32(c.MaxInFlight),
+		Rate:        c.Rate,
+		Strategy:    strategyToProto(c.Strategy),
+		HashParam:   c.HashParam,
+		Steal:       c.Steal,
+	}
+}
+
+func strategyToProto(s contract.Strategy) pb.Strategy {
+	return pb.Strategy(pb.Strategy_value[strings.ToUpper(string(s))])
+}
+
+func strategyFromProto(s pb.Strategy) contract.Strategy {
+	if s == pb.Strategy_STRATEGY_UNSPECIFIED {
+		return ""
+	}
+	return contract.Strategy(strings.ToLower(s.String()))
+}
+
+func kindConfigFromProto(c *pb.KindConfig) contract.KindConfig {
+	return contract.KindConfig{
+		Kind:        c.GetKind(),
+		Ordered:     c.GetOrdered(),
+		MaxInFlight: int(c.GetMaxInFlight()),
+		Rate:        c.GetRate(),
+		Strategy:    strategyFromProto(c.GetStrategy()),
+		HashParam:   c.GetHashParam(),
+		Steal:       c.GetSteal(),
+	}
+}
+
+func namespaceToProto(ns contract.Namespace) *pb.Namespace {
+	return &pb.Namespace{
+		Name:     ns.Name,
+		MaxTasks: ns.MaxTasks,
+		MaxBytes: ns.MaxBytes,
+		Tasks:    ns.Usage.Tasks,
+		Bytes:    ns.Usage.Bytes,
+	}
+}
+
+func namespaceFromProto(ns *pb.Namespace) contract.Namespace {
+	return contract.Namespace{
+		Name:     ns.GetName(),
+		MaxTasks: ns.GetMaxTasks(),
+		MaxBytes: ns.GetMaxBytes(),
+	}
+}
+
+func taskUpdateFromProto(up *pb.TaskUpdate) contract.TaskUpdate {
+	r := contract.TaskUpdate{
+		Kind:  up.Kind,
+		Group: up.Group,
+		Owner: up.Owner,
+		Param: up.GetParam(),
+		Error: up.Error,
+	}
+	if up.Status != nil {
+		s := contract.Status(*up.Status)
+		r.Status = &s
+	}
+	return r
+}
+
+func conditionFromProto(c *pb.Condition) (*contract.Condition, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	r := &contract.Condition{}
+	if c.LogicalOperator != nil {
+		lop := contract.LogicalOperator(*c.LogicalOperator)
+		if lop != contract.And && lop != contract.Or {
+			return nil, fmt.Errorf("unknown logical operator: %v", lop)
+		}
+		r.LogicalOperator = &lop
+	}
+	for _, op := range c.GetOperations() {
+		r.Operations = append(r.Operations, contract.Operation{
+			Operator: contract.Operator(op.GetOperator()),
+			Field:    op.GetField(),
+			// same types as a json decoded http condition: string, float64, bool or nil
+			Value: op.GetValue().AsInterface(),
+		})
+	}
+	for _, cond := range c.GetConditions() {
+		sub, err := conditionFromProto(cond)
+		if err != nil {
+			return nil, err
+		}
+		r.Conditions = append(r.Conditions, *sub)
+	}
+
+	return r, nil
+}
+
+func sizeFromProto(size *uint64) *uint {
+	if size == nil {
+		return nil
+	}
+	s := uint(*size)
+	return &s
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	pb "github.com/esaseleznev/taskstoredb/pkg/taskstorepb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestTaskConvert_Condition(t *testing.T) {
+	or := string(contract.Or)
+	c := &pb.Condition{
+		LogicalOperator: &or,
+		Operations: []*pb.Operation{
+			{Operator: "=", Field: "kind", Value: structpb.NewStringValue("TEST")},
+			{Operator: ">", Field: "status", Value: structpb.NewNumberValue(1)},
+		},
+		Conditions: []*pb.Condition{
+			{Operations: []*pb.Operation{{Operator: "=", Field: "error", Value: structpb.NewNullValue()}}},
+		},
+	}
+
+	out, err := conditionFromProto(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *out.LogicalOperator != contract.Or {
+		t.Errorf("not correct logical operator %v", *out.LogicalOperator)
+	}
+	if out.Operations[0].Value != "TEST" || out.Operations[1].Value != float64(1) {
+		t.Errorf("not correct operation values %+v", out.Operations)
+	}
+	if len(out.Conditions) != 1 || out.Conditions[0].Operations[0].Value != nil {
+		t.Errorf("not correct nested condition %+v", out.Conditions)
+	}
+
+	bad := "XOR"
+	_, err = conditionFromProto(&pb.Condition{LogicalOperator: &bad})
+	if err == nil {
+		t.Errorf("expected unknown logical operator error")
+	}
+}
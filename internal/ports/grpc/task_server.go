@@ -0,0 +1,322 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/esaseleznev/taskstoredb/internal/app"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+	pb "github.com/esaseleznev/taskstoredb/pkg/taskstorepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	incompleteKey = "x-result-incomplete"
+	nodeStatusKey = "x-node-status"
+)
+
+type TaskServer struct {
+	pb.UnimplementedTaskStoreServer
+	apps *app.Namespaces
+}
+
+func NewTaskServer(apps *app.Namespaces) *TaskServer {
+	return &TaskServer{apps: apps}
+}
+
+// app is the application of the namespace of the call.
+func (s *TaskServer) app(ctx context.Context) app.Application {
+	return application(ctx, s.apps)
+}
+
+func streamTasks(stream grpc.ServerStreamingServer[pb.Task], tasks []contract.Task) error {
+	for _, t := range tasks {
+		if err := stream.Send(taskToProto(t)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// partialTrailer flags an incomplete result and reports every node as "node=state[: error]".
+func partialTrailer(stream grpc.ServerStream, nodes []contract.NodeStatus) {
+	md := metadata.MD{}
+	for _, node := range nodes {
+		v := node.Node + "=" + string(node.State)
+		if node.Error != nil {
+			v += ": " + *node.Error
+		}
+		md.Append(nodeStatusKey, v)
+		if node.State != contract.NodeOk {
+			md.Set(incompleteKey, "true")
+		}
+	}
+	stream.SetTrailer(md)
+}
+
+func (s *TaskServer) Add(ctx context.Context, r *pb.AddRequest) (*pb.AddResponse, error) {
+	id, err := s.app(ctx).Commands.AddTask.Handle(ctx, r.GetGroup(), r.GetKind(), r.Owner, r.GetParam())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.AddResponse{Id: id}, nil
+}
+
+func (s *TaskServer) Update(ctx context.Context, r *pb.UpdateRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.UpdateTask.Handle(
+		ctx,
+		r.GetGroup(),
+		r.GetId(),
+		contract.Status(r.GetStatus()),
+		r.GetParam(),
+		r.Error,
+	)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) Get(ctx context.Context, r *pb.GetRequest) (*pb.GetResponse, error) {
+	task, err := s.app(ctx).Queries.Get.Handle(ctx, r.GetGroup(), r.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.GetResponse{}
+	if task != nil {
+		res.Task = taskToProto(*task)
+	}
+	return res, nil
+}
+
+func (s *TaskServer) GetFirstInGroup(ctx context.Context, r *pb.GetFirstInGroupRequest) (*pb.GetFirstInGroupResponse, error) {
+	id, err := s.app(ctx).Queries.GetFirstInGroup.Handle(ctx, r.GetGroup())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetFirstInGroupResponse{Id: id}, nil
+}
+
+func (s *TaskServer) Pool(r *pb.PoolRequest, stream grpc.ServerStreamingServer[pb.Task]) error {
+	tasks, nodes, err := s.app(stream.Context()).Queries.Pool.Handle(
+		stream.Context(),
+		r.GetOwner(),
+		r.GetKind(),
+		false,
+		r.GetAllowPartial(),
+		r.GetWait().AsDuration(),
+	)
+	if err != nil {
+		return toStatus(err)
+	}
+	if r.GetAllowPartial() {
+		partialTrailer(stream, nodes)
+	}
+	return streamTasks(stream, tasks)
+}
+
+func (s *TaskServer) SearchTask(r *pb.SearchRequest, stream grpc.ServerStreamingServer[pb.Task]) error {
+	condition, err := conditionFromProto(r.GetCondition())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	tasks, nodes, err := s.app(stream.Context()).Queries.SearchTask.Handle(
+		stream.Context(),
+		condition,
+		r.Kind,
+		sizeFromProto(r.Size),
+		false,
+		r.GetAllowPartial(),
+	)
+	if err != nil {
+		return toStatus(err)
+	}
+	if r.GetAllowPartial() {
+		partialTrailer(stream, nodes)
+	}
+	return streamTasks(stream, tasks)
+}
+
+func (s *TaskServer) SearchError(r *pb.SearchRequest, stream grpc.ServerStreamingServer[pb.Task]) error {
+	condition, err := conditionFromProto(r.GetCondition())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	tasks, nodes, err := s.app(stream.Context()).Queries.SearchError.Handle(
+		stream.Context(),
+		condition,
+		r.Kind,
+		sizeFromProto(r.Size),
+		false,
+		r.GetAllowPartial(),
+	)
+	if err != nil {
+		return toStatus(err)
+	}
+	if r.GetAllowPartial() {
+		partialTrailer(stream, nodes)
+	}
+	return streamTasks(stream, tasks)
+}
+
+func (s *TaskServer) SearchDeleteTask(ctx context.Context, r *pb.SearchRequest) (*pb.Empty, error) {
+	condition, err := conditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchDeleteTask.Handle(ctx, condition, r.Kind, sizeFromProto(r.Size), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) SearchDeleteErrorTask(ctx context.Context, r *pb.SearchRequest) (*pb.Empty, error) {
+	condition, err := conditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchDeleteErrorTask.Handle(ctx, condition, r.Kind, sizeFromProto(r.Size), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) SearchUpdateTask(ctx context.Context, r *pb.SearchUpdateRequest) (*pb.Empty, error) {
+	condition, err := conditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchUpdateTask.Handle(
+		ctx,
+		taskUpdateFromProto(r.GetUp()),
+		condition,
+		r.Kind,
+		sizeFromProto(r.Size),
+		false,
+	)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) SearchUpdateErrorTask(ctx context.Context, r *pb.SearchUpdateRequest) (*pb.Empty, error) {
+	condition, err := conditionFromProto(r.GetCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.app(ctx).Commands.SearchUpdateErrorTask.Handle(
+		ctx,
+		taskUpdateFromProto(r.GetUp()),
+		condition,
+		r.Kind,
+		sizeFromProto(r.Size),
+		false,
+	)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) OwnerReg(ctx context.Context, r *pb.OwnerRegRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.OwnerReg.Handle(ctx, r.GetOwner(), r.GetKinds(), r.GetTtl().AsDuration(), int(r.GetWeight()), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) OwnerHeartbeat(ctx context.Context, r *pb.OwnerHeartbeatRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.OwnerHeartbeat.Handle(ctx, r.GetOwner(), r.GetTtl().AsDuration(), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) OwnerUnReg(ctx context.Context, r *pb.OwnerUnRegRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.OwnerUnReg.Handle(ctx, r.GetOwner(), r.GetKind(), r.GetHandoff(), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) Pause(ctx context.Context, r *pb.PauseRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.Pause.Handle(ctx, r.GetKind(), r.GetGroup(), true, false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) Resume(ctx context.Context, r *pb.PauseRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.Pause.Handle(ctx, r.GetKind(), r.GetGroup(), false, false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) Paused(ctx context.Context, r *pb.Empty) (*pb.PausedResponse, error) {
+	pauses, err := s.app(ctx).Queries.Paused.Handle(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.PausedResponse{}
+	for _, p := range pauses {
+		res.Pauses = append(res.Pauses, pauseToProto(p))
+	}
+	return res, nil
+}
+
+func (s *TaskServer) KindConfigSet(ctx context.Context, r *pb.KindConfig) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.KindConfig.Handle(ctx, kindConfigFromProto(r), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) KindConfigs(ctx context.Context, r *pb.Empty) (*pb.KindConfigsResponse, error) {
+	configs, err := s.app(ctx).Queries.KindConfigs.Handle(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.KindConfigsResponse{}
+	for _, c := range configs {
+		res.Configs = append(res.Configs, kindConfigToProto(c))
+	}
+	return res, nil
+}
+
+func (s *TaskServer) KindOwners(ctx context.Context, r *pb.Empty) (*pb.KindOwnersResponse, error) {
+	kinds, err := s.app(ctx).Queries.KindOwners.Handle(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.KindOwnersResponse{}
+	for _, k := range kinds {
+		weights := make([]int32, 0, len(k.Weights))
+		for _, w := range k.Weights {
+			weights = append(weights, int32(w))
+		}
+		res.Kinds = append(res.Kinds, &pb.KindOwners{
+			Kind:     k.Kind,
+			Owners:   k.Owners,
+			Weights:  weights,
+			Strategy: strategyToProto(k.Strategy),
+		})
+	}
+	return res, nil
+}
+
+func (s *TaskServer) InFlight(ctx context.Context, r *pb.InFlightRequest) (*pb.InFlightResponse, error) {
+	n, err := s.app(ctx).Queries.InFlight.Handle(ctx, r.GetKind(), false)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.InFlightResponse{Count: int64(n)}, nil
+}
+
+func (s *TaskServer) HealthCheck(ctx context.Context, r *pb.Empty) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.HealthCheck.Handle(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *TaskServer) NamespaceCreate(ctx context.Context, r *pb.Namespace) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.NamespaceCreate.Handle(ctx, namespaceFromProto(r), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) NamespaceDrop(ctx context.Context, r *pb.NamespaceDropRequest) (*pb.Empty, error) {
+	err := s.app(ctx).Commands.NamespaceDrop.Handle(ctx, r.GetName(), false)
+	return &pb.Empty{}, toStatus(err)
+}
+
+func (s *TaskServer) Namespaces(ctx context.Context, r *pb.Empty) (*pb.NamespacesResponse, error) {
+	namespaces, err := s.app(ctx).Queries.Namespaces.Handle(ctx, false)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.NamespacesResponse{}
+	for _, ns := range namespaces {
+		res.Namespaces = append(res.Namespaces, namespaceToProto(ns))
+	}
+	return res, nil
+}
@@ -0,0 +1,219 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/esaseleznev/taskstoredb/internal/app"
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	eventsPath        = "/events"
+	eventsKeepAlive   = 15 * time.Second
+	lastEventIdHeader = "Last-Event-ID"
+)
+
+// eventsCondition ANDs the kind, group, owner and status query params with
+// an optional json condition in param 'c'.
+func eventsCondition(r *http.Request) (*contract.Condition, error) {
+	and := contract.And
+	condition := contract.Condition{LogicalOperator: &and}
+	for _, field := range []string{"kind", "group", "owner", "status"} {
+		if v := r.URL.Query().Get(field); v != "" {
+			condition.Operations = append(condition.Operations, contract.Operation{
+				Operator: contract.Equal,
+				Field:    field,
+				Value:    v,
+			})
+		}
+	}
+	if c := r.URL.Query().Get("c"); c != "" {
+		nested := contract.Condition{}
+		if err := json.Unmarshal([]byte(c), &nested); err != nil {
+			return nil, newBadRequestError(errors.New("bad query param 'c'"))
+		}
+		condition.Conditions = append(condition.Conditions, nested)
+	}
+	if len(condition.Operations) == 0 && len(condition.Conditions) == 0 {
+		return nil, nil
+	}
+	return &condition, nil
+}
+
+// eventsFrom is the cluster cursor to resume after, "index.offset" of every
+// node taken from the Last-Event-ID header sent by reconnecting EventSource
+// clients or the 'from' param.
+func eventsFrom(a app.Application, r *http.Request) (from contract.StreamCursor, err error) {
+	v := r.Header.Get(lastEventIdHeader)
+	if v == "" {
+		v = r.URL.Query().Get("from")
+	}
+	if from, err = a.Queries.Events.Cursor(v); err != nil {
+		return from, newBadRequestError(errors.New("bad resume index"))
+	}
+	return from, nil
+}
+
+// nodeFrom is the event of the node to resume after, 'from' of a cluster call.
+func nodeFrom(r *http.Request) (from contract.EventCursor, err error) {
+	v := r.URL.Query().Get("from")
+	if v == "" {
+		return from, nil
+	}
+	if from, err = contract.ParseEventCursor(v); err != nil {
+		return from, newBadRequestError(errors.New("bad resume index"))
+	}
+	return from, nil
+}
+
+// nodeEventsEnd is the last event of a node stream of a cluster call, so the
+// calling node tells a slow subscriber from a failed stream.
+func nodeEventsEnd(err error) (event string, data []byte) {
+	event = "error"
+	if errors.Is(err, contract.ErrSlowSubscriber) {
+		event = "slow"
+	}
+	data, _ = json.Marshal(NewErrorResult(err))
+	return event, data
+}
+
+func Events(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	condition, err := eventsCondition(r)
+	if err != nil {
+		return err
+	}
+	internal, err := boolQuery(r, "internal")
+	if err != nil {
+		return err
+	}
+	var stream func(ctx context.Context, send func(e contract.TaskEvent) error) error
+	if internal {
+		from, err := nodeFrom(r)
+		if err != nil {
+			return err
+		}
+		stream = func(ctx context.Context, send func(e contract.TaskEvent) error) error {
+			return a.Queries.Events.Node(ctx, condition, from, send)
+		}
+	} else {
+		from, err := eventsFrom(a, r)
+		if err != nil {
+			return err
+		}
+		stream = func(ctx context.Context, send func(e contract.TaskEvent) error) error {
+			return a.Queries.Events.Handle(ctx, condition, from, send)
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	// the stream outlives the server write timeout
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil {
+		return fmt.Errorf("events stream is not supported: %v", err)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err = rc.Flush(); err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// events and keepalive comments share the stream
+	var mu sync.Mutex
+	write := func(format string, args ...any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	go func() {
+		ticker := time.NewTicker(eventsKeepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := write(": keepalive\n\n"); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = stream(ctx, func(e contract.TaskEvent) error {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("event marshal error: %v", err)
+		}
+		id := e.Resume
+		if internal {
+			id = e.Cursor().String()
+		}
+		return write("id: %s\nevent: %s\ndata: %s\n\n", id, e.Type, data)
+	})
+	if err != nil && internal && ctx.Err() == nil {
+		event, data := nodeEventsEnd(err)
+		_ = write("event: %s\ndata: %s\n\n", event, data)
+	}
+	// the response is already streaming, the client reconnects with Last-Event-ID
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Printf("events stream closed: %s\n", err)
+	}
+	return nil
+}
+
+func EventsWs(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	condition, err := eventsCondition(r)
+	if err != nil {
+		return err
+	}
+	from, err := eventsFrom(a, r)
+	if err != nil {
+		return err
+	}
+
+	// the stream outlives the server write timeout
+	if err = http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		return fmt.Errorf("events stream is not supported: %v", err)
+	}
+	c, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		// Accept has already written the response
+		return nil
+	}
+	defer c.CloseNow()
+
+	// nothing is expected from the client, reading handles pings and close
+	ctx := c.CloseRead(r.Context())
+	err = a.Queries.Events.Handle(ctx, condition, from, func(e contract.TaskEvent) error {
+		return wsjson.Write(ctx, c, e)
+	})
+	switch {
+	case errors.Is(err, contract.ErrSlowSubscriber):
+		// the client resumes after the last event it got
+		c.Close(websocket.StatusTryAgainLater, err.Error())
+	case errors.Is(err, access.ErrForbidden):
+		c.Close(websocket.StatusPolicyViolation, err.Error())
+	case err != nil && !errors.Is(err, context.Canceled):
+		log.Printf("events stream closed: %s\n", err)
+		c.Close(websocket.StatusInternalError, "events stream failed")
+	}
+	return nil
+}
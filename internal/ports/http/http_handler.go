@@ -2,9 +2,11 @@ package http
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"sync/atomic"
+	"time"
 
 	"github.com/esaseleznev/taskstoredb/internal/app"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
@@ -19,6 +21,40 @@ func emptyBody(w http.ResponseWriter) error {
 	return nil
 }
 
+func encodeTasks(
+	w http.ResponseWriter,
+	tasks []contract.Task,
+	nodes []contract.NodeStatus,
+	allowPartial bool,
+) error {
+	if len(tasks) == 0 {
+		tasks = []contract.Task{}
+	}
+	if !allowPartial {
+		return encode(w, int(http.StatusOK), tasks)
+	}
+
+	for _, node := range nodes {
+		if node.State != contract.NodeOk {
+			w.Header().Set(incompleteHeader, "true")
+			break
+		}
+	}
+	return encode(w, int(http.StatusOK), contract.PartialTasksResponse{Tasks: tasks, Nodes: nodes})
+}
+
+func boolQuery(r *http.Request, name string) (bool, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, newBadRequestError(fmt.Errorf("bad query param '%s'", name))
+	}
+	return b, nil
+}
+
 func (h *HttpServer) HealthCheck() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if atomic.LoadInt32(&h.healthy) == 0 {
@@ -26,7 +62,7 @@ func (h *HttpServer) HealthCheck() http.HandlerFunc {
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return
 		}
-		if err := h.app.Commands.HealthCheck.Handle(); err != nil {
+		if err := h.apps.Default().Commands.HealthCheck.Handle(r.Context()); err != nil {
 			h.logger.Printf("Health check failed: %v", err)
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return
@@ -41,7 +77,7 @@ func Add(a app.Application, w http.ResponseWriter, r *http.Request) error {
 		return newBadRequestError(err)
 	}
 
-	id, err := a.Commands.AddTask.Handle(t.Group, t.Kind, t.Owner, t.Param)
+	id, err := a.Commands.AddTask.Handle(r.Context(), t.Group, t.Kind, t.Owner, t.Param)
 	if err != nil {
 		return err
 	}
@@ -56,6 +92,7 @@ func Update(a app.Application, w http.ResponseWriter, r *http.Request) error {
 	}
 
 	err = a.Commands.UpdateTask.Handle(
+		r.Context(),
 		t.Group,
 		t.Id,
 		contract.Status(t.Status),
@@ -69,13 +106,57 @@ func Update(a app.Application, w http.ResponseWriter, r *http.Request) error {
 	return emptyBody(w)
 }
 
+func AddBatch(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	b, err := decode[contract.BatchAddRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	items, err := a.Commands.AddTaskBatch.Handle(r.Context(), b.Tasks)
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), contract.BatchResponse{Items: items})
+}
+
+func UpdateBatch(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	b, err := decode[contract.BatchUpdateRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	items, err := a.Commands.UpdateTaskBatch.Handle(r.Context(), b.Tasks)
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), contract.BatchResponse{Items: items})
+}
+
 func OwnerReg(a app.Application, w http.ResponseWriter, r *http.Request) error {
 	o, err := decode[contract.OwnerRegRequest](r)
 	if err != nil {
 		return newBadRequestError(err)
 	}
 
-	err = a.Commands.OwnerReg.Handle(o.Owner, o.Kinds, o.Internal)
+	ttl := time.Duration(o.Ttl) * time.Millisecond
+	err = a.Commands.OwnerReg.Handle(r.Context(), o.Owner, o.Kinds, ttl, o.Weight, o.Internal)
+	if err != nil {
+		return err
+	}
+
+	return emptyBody(w)
+}
+
+func OwnerHeartbeat(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	o, err := decode[contract.OwnerHeartbeatRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	ttl := time.Duration(o.Ttl) * time.Millisecond
+	err = a.Commands.OwnerHeartbeat.Handle(r.Context(), o.Owner, ttl, o.Internal)
 	if err != nil {
 		return err
 	}
@@ -89,7 +170,133 @@ func OwnerUnReg(a app.Application, w http.ResponseWriter, r *http.Request) error
 		return newBadRequestError(err)
 	}
 
-	err = a.Commands.OwnerUnReg.Handle(o.Owner, o.Internal)
+	err = a.Commands.OwnerUnReg.Handle(r.Context(), o.Owner, o.Kind, o.Handoff, o.Internal)
+	if err != nil {
+		return err
+	}
+
+	return emptyBody(w)
+}
+
+func Pause(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	return pause(a, w, r, true)
+}
+
+func Resume(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	return pause(a, w, r, false)
+}
+
+func pause(a app.Application, w http.ResponseWriter, r *http.Request, paused bool) error {
+	p, err := decode[contract.PauseRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	err = a.Commands.Pause.Handle(r.Context(), p.Kind, p.Group, paused, p.Internal)
+	if err != nil {
+		return err
+	}
+
+	return emptyBody(w)
+}
+
+func Paused(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	pauses, err := a.Queries.Paused.Handle(r.Context())
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), pauses)
+}
+
+func KindConfigSet(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	c, err := decode[contract.KindConfigRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	err = a.Commands.KindConfig.Handle(r.Context(), c.KindConfig, c.Internal)
+	if err != nil {
+		return err
+	}
+
+	return emptyBody(w)
+}
+
+func KindConfigs(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	configs, err := a.Queries.KindConfigs.Handle(r.Context())
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), configs)
+}
+
+func InFlight(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	kind := r.PathValue("kind")
+	if kind == "" {
+		return newBadRequestError(errors.New("not found query param 'kind'"))
+	}
+	internal, err := boolQuery(r, "internal")
+	if err != nil {
+		return err
+	}
+
+	n, err := a.Queries.InFlight.Handle(r.Context(), kind, internal)
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), contract.InFlightResponse{Count: n})
+}
+
+func WebhookReg(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	wh, err := decode[contract.WebhookRegRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	id, err := a.Commands.WebhookReg.Handle(r.Context(), wh.Webhook, wh.Internal)
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), contract.WebhookRegResponse{Id: id})
+}
+
+func WebhookUnReg(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	wh, err := decode[contract.WebhookUnRegRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	err = a.Commands.WebhookUnReg.Handle(r.Context(), wh.Id, wh.Internal)
+	if err != nil {
+		return err
+	}
+
+	return emptyBody(w)
+}
+
+func Webhooks(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	webhooks, err := a.Queries.Webhooks.Handle(r.Context())
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		webhooks = []contract.Webhook{}
+	}
+
+	return encode(w, int(http.StatusOK), webhooks)
+}
+
+func NamespaceCreate(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	ns, err := decode[contract.NamespaceRequest](r)
+	if err != nil {
+		return newBadRequestError(err)
+	}
+
+	err = a.Commands.NamespaceCreate.Handle(r.Context(), ns.Namespace, ns.Internal)
 	if err != nil {
 		return err
 	}
@@ -97,13 +304,81 @@ func OwnerUnReg(a app.Application, w http.ResponseWriter, r *http.Request) error
 	return emptyBody(w)
 }
 
+func NamespaceDrop(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	name := r.PathValue("name")
+	if name == "" {
+		return newBadRequestError(errors.New("not found query param 'name'"))
+	}
+	internal, err := boolQuery(r, "internal")
+	if err != nil {
+		return err
+	}
+
+	err = a.Commands.NamespaceDrop.Handle(r.Context(), name, internal)
+	if err != nil {
+		return err
+	}
+
+	return emptyBody(w)
+}
+
+func Namespaces(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	internal, err := boolQuery(r, "internal")
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := a.Queries.Namespaces.Handle(r.Context(), internal)
+	if err != nil {
+		return err
+	}
+	if len(namespaces) == 0 {
+		namespaces = []contract.Namespace{}
+	}
+
+	return encode(w, int(http.StatusOK), namespaces)
+}
+
+func Owners(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	owners, err := a.Queries.Owners.Handle(r.Context())
+	if err != nil {
+		return err
+	}
+	if len(owners) == 0 {
+		owners = []contract.OwnerKinds{}
+	}
+
+	return encode(w, int(http.StatusOK), owners)
+}
+
+func KindOwners(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	kinds, err := a.Queries.KindOwners.Handle(r.Context())
+	if err != nil {
+		return err
+	}
+	if len(kinds) == 0 {
+		kinds = []contract.KindOwners{}
+	}
+
+	return encode(w, int(http.StatusOK), kinds)
+}
+
+func Status(a app.Application, w http.ResponseWriter, r *http.Request) error {
+	status, err := a.Queries.Status.Handle(r.Context())
+	if err != nil {
+		return err
+	}
+
+	return encode(w, int(http.StatusOK), status)
+}
+
 func GetFirstInGroup(a app.Application, w http.ResponseWriter, r *http.Request) error {
 	group := r.PathValue("group")
 	if group == "" {
 		return newBadRequestError(errors.New("not found query param 'group'"))
 	}
 
-	id, err := a.Queries.GetFirstInGroup.Handle(group)
+	id, err := a.Queries.GetFirstInGroup.Handle(r.Context(), group)
 	if err != nil {
 		return err
 	}
@@ -120,27 +395,40 @@ func Pool(a app.Application, w http.ResponseWriter, r *http.Request) error {
 	if kind == "" {
 		return newBadRequestError(errors.New("not found query param 'kind'"))
 	}
-	var internal bool
-	internalStr := r.URL.Query().Get("internal")
-	if internalStr == "" {
-		internal = false
-	} else {
-		var err error
-		internal, err = strconv.ParseBool(internalStr)
-		if err != nil {
-			return newBadRequestError(errors.New("bad query param 'internal'"))
+	internal, err := boolQuery(r, "internal")
+	if err != nil {
+		return err
+	}
+	allowPartial, err := boolQuery(r, "allowPartial")
+	if err != nil {
+		return err
+	}
+
+	var wait time.Duration
+	if waitStr := r.URL.Query().Get("wait"); waitStr != "" {
+		wait, err = time.ParseDuration(waitStr)
+		if err != nil || wait < 0 {
+			return newBadRequestError(errors.New("bad query param 'wait'"))
 		}
 	}
 
-	tasks, err := a.Queries.Pool.Handle(owner, kind, internal)
+	lease, err := boolQuery(r, "lease")
 	if err != nil {
 		return err
 	}
-	if len(tasks) == 0 {
-		tasks = []contract.Task{}
+
+	var tasks []contract.Task
+	var nodes []contract.NodeStatus
+	if lease {
+		tasks, nodes, err = a.Queries.Pool.Lease(r.Context(), owner, kind, allowPartial, wait)
+	} else {
+		tasks, nodes, err = a.Queries.Pool.Handle(r.Context(), owner, kind, internal, allowPartial, wait)
+	}
+	if err != nil {
+		return err
 	}
 
-	return encode(w, int(http.StatusOK), tasks)
+	return encodeTasks(w, tasks, nodes, allowPartial)
 }
 
 func Get(a app.Application, w http.ResponseWriter, r *http.Request) error {
@@ -153,7 +441,7 @@ func Get(a app.Application, w http.ResponseWriter, r *http.Request) error {
 		return newBadRequestError(errors.New("not found query param 'id'"))
 	}
 
-	task, err := a.Queries.Get.Handle(group, id)
+	task, err := a.Queries.Get.Handle(r.Context(), group, id)
 	if err != nil {
 		return err
 	}
@@ -167,19 +455,18 @@ func SearchTask(a app.Application, w http.ResponseWriter, r *http.Request) error
 		return newBadRequestError(err)
 	}
 
-	tasks, err := a.Queries.SearchTask.Handle(
+	tasks, nodes, err := a.Queries.SearchTask.Handle(
+		r.Context(),
 		o.Condition,
 		o.Kind,
 		o.Size,
 		o.Internal,
+		o.AllowPartial,
 	)
 	if err != nil {
 		return err
 	}
-	if len(tasks) == 0 {
-		tasks = []contract.Task{}
-	}
-	return encode(w, int(http.StatusOK), tasks)
+	return encodeTasks(w, tasks, nodes, o.AllowPartial)
 }
 
 func SearchError(a app.Application, w http.ResponseWriter, r *http.Request) error {
@@ -188,19 +475,18 @@ func SearchError(a app.Application, w http.ResponseWriter, r *http.Request) erro
 		return newBadRequestError(err)
 	}
 
-	tasks, err := a.Queries.SearchError.Handle(
+	tasks, nodes, err := a.Queries.SearchError.Handle(
+		r.Context(),
 		o.Condition,
 		o.Kind,
 		o.Size,
 		o.Internal,
+		o.AllowPartial,
 	)
 	if err != nil {
 		return err
 	}
-	if len(tasks) == 0 {
-		tasks = []contract.Task{}
-	}
-	return encode(w, int(http.StatusOK), tasks)
+	return encodeTasks(w, tasks, nodes, o.AllowPartial)
 }
 
 func SearchDeleteTask(a app.Application, w http.ResponseWriter, r *http.Request) error {
@@ -210,6 +496,7 @@ func SearchDeleteTask(a app.Application, w http.ResponseWriter, r *http.Request)
 	}
 
 	err = a.Commands.SearchDeleteTask.Handle(
+		r.Context(),
 		o.Condition,
 		o.Kind,
 		o.Size,
@@ -228,6 +515,7 @@ func SearchDeleteErrorTask(a app.Application, w http.ResponseWriter, r *http.Req
 	}
 
 	err = a.Commands.SearchDeleteErrorTask.Handle(
+		r.Context(),
 		o.Condition,
 		o.Kind,
 		o.Size,
@@ -246,6 +534,7 @@ func SearchUpdateTask(a app.Application, w http.ResponseWriter, r *http.Request)
 	}
 
 	err = a.Commands.SearchUpdateTask.Handle(
+		r.Context(),
 		o.Up,
 		o.Condition,
 		o.Kind,
@@ -265,6 +554,7 @@ func SearchUpdateErrorTask(a app.Application, w http.ResponseWriter, r *http.Req
 	}
 
 	err = a.Commands.SearchUpdateErrorTask.Handle(
+		r.Context(),
 		o.Up,
 		o.Condition,
 		o.Kind,
@@ -2,20 +2,25 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/esaseleznev/taskstoredb/internal/app"
+	"github.com/esaseleznev/taskstoredb/internal/app/access"
 	"github.com/esaseleznev/taskstoredb/internal/contract"
+	"github.com/esaseleznev/taskstoredb/internal/ports/auth"
 )
 
 type key int
@@ -24,12 +29,21 @@ const (
 	requestIDKey key = 0
 )
 
+const (
+	incompleteHeader = "X-Result-Incomplete"
+	nsPath           = "/ns/"
+)
+
 type handlerFunc func(a app.Application, w http.ResponseWriter, r *http.Request) error
 
 type HttpServer struct {
 	port    string
-	app     app.Application
+	timeout time.Duration
+	apps    *app.Namespaces
 	logger  *log.Logger
+	auth    auth.Authenticator
+	tls     *tls.Config
+	metrics Metrics
 	healthy int32
 }
 
@@ -44,11 +58,23 @@ func (e HttpError) Error() string {
 
 func (h HttpServer) handle(f handlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if err := f(h.app, w, r); err != nil {
+		a, err := h.apps.Application(contract.NamespaceFrom(r.Context()))
+		if err == nil {
+			err = f(a, w, r)
+		}
+		if err != nil {
 			status := http.StatusInternalServerError
 			var httpError HttpError
 			if errors.As(err, &httpError) {
 				status = httpError.Status
+			} else if errors.Is(err, context.DeadlineExceeded) {
+				status = http.StatusGatewayTimeout
+			} else if errors.Is(err, access.ErrForbidden) {
+				status = http.StatusForbidden
+			} else if errors.Is(err, contract.ErrNamespaceNotFound) {
+				status = http.StatusNotFound
+			} else if errors.As(err, &contract.QuotaError{}) {
+				status = http.StatusTooManyRequests
 			}
 
 			if err := encode(w, int(status), NewErrorResult(err)); err != nil {
@@ -64,11 +90,23 @@ func NewErrorResult(err error) contract.ErrorResponse {
 	}
 }
 
-func NewHttpServer(port string, app app.Application, logger *log.Logger) HttpServer {
+func NewHttpServer(
+	port string,
+	timeout time.Duration,
+	apps *app.Namespaces,
+	logger *log.Logger,
+	authenticator auth.Authenticator,
+	tlsConfig *tls.Config,
+	metrics Metrics,
+) HttpServer {
 	return HttpServer{
-		port:   port,
-		app:    app,
-		logger: logger,
+		port:    port,
+		timeout: timeout,
+		apps:    apps,
+		logger:  logger,
+		auth:    authenticator,
+		tls:     tlsConfig,
+		metrics: metrics,
 	}
 }
 
@@ -76,8 +114,20 @@ func (h *HttpServer) Start() error {
 	http.HandleFunc("GET /healthz", h.HealthCheck())
 	http.HandleFunc("POST /task", h.handle(Add))
 	http.HandleFunc("PATCH /task", h.handle(Update))
+	http.HandleFunc("POST /task/batch", h.handle(AddBatch))
+	http.HandleFunc("PATCH /task/batch", h.handle(UpdateBatch))
 	http.HandleFunc("PUT /owner/reg", h.handle(OwnerReg))
 	http.HandleFunc("PUT /owner/unreg", h.handle(OwnerUnReg))
+	http.HandleFunc("PUT /owner/heartbeat", h.handle(OwnerHeartbeat))
+	http.HandleFunc("GET /owner", h.handle(Owners))
+	http.HandleFunc("GET /status", h.handle(Status))
+	http.HandleFunc("PUT /pause", h.handle(Pause))
+	http.HandleFunc("PUT /resume", h.handle(Resume))
+	http.HandleFunc("GET /pause", h.handle(Paused))
+	http.HandleFunc("PUT /kind/config", h.handle(KindConfigSet))
+	http.HandleFunc("GET /kind/config", h.handle(KindConfigs))
+	http.HandleFunc("GET /kind/owners", h.handle(KindOwners))
+	http.HandleFunc("GET /kind/{kind}/inflight", h.handle(InFlight))
 	http.HandleFunc("GET /task/{id}/group/{group}", h.handle(Get))
 	http.HandleFunc("GET /task/group/{group}", h.handle(GetFirstInGroup))
 	http.HandleFunc("GET /pool/{owner}/kind/{kind}", h.handle(Pool))
@@ -87,16 +137,35 @@ func (h *HttpServer) Start() error {
 	http.HandleFunc("POST /error/search/delete", h.handle(SearchDeleteErrorTask))
 	http.HandleFunc("POST /task/search/update", h.handle(SearchUpdateTask))
 	http.HandleFunc("POST /error/search/update", h.handle(SearchUpdateErrorTask))
+	http.HandleFunc("PUT /webhook/reg", h.handle(WebhookReg))
+	http.HandleFunc("PUT /webhook/unreg", h.handle(WebhookUnReg))
+	http.HandleFunc("GET /webhook", h.handle(Webhooks))
+	http.HandleFunc("GET "+eventsPath, h.handle(Events))
+	http.HandleFunc("GET "+eventsPath+"/ws", h.handle(EventsWs))
+	http.HandleFunc("PUT /namespace", h.handle(NamespaceCreate))
+	http.HandleFunc("GET /namespace", h.handle(Namespaces))
+	http.HandleFunc("DELETE /namespace/{name}", h.handle(NamespaceDrop))
+	if h.metrics != nil {
+		http.Handle("GET /metrics", h.metrics.Handler())
+	}
 
 	nextRequestID := func() string {
 		return strconv.FormatInt(time.Now().UnixNano(), 10)
 	}
+	// cancelled when graceful shutdown gives up, aborts requests still in flight
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	defer cancelBase()
+
 	server := &http.Server{
 		Addr:         ":" + h.port,
-		Handler:      h.Tracing(nextRequestID)(h.Logging(h.logger)(http.DefaultServeMux)),
+		Handler:      h.Tracing(nextRequestID)(h.Logging(h.logger)(h.Namespace()(h.Metrics(h.metrics, http.DefaultServeMux)(h.Auth(h.auth)(h.Deadline(h.timeout)(http.DefaultServeMux)))))),
 		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		WriteTimeout: h.timeout + 5*time.Second,
 		IdleTimeout:  15 * time.Second,
+		TLSConfig:    h.tls,
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
 	}
 
 	done := make(chan bool)
@@ -116,12 +185,18 @@ func (h *HttpServer) Start() error {
 		if err := server.Shutdown(ctx); err != nil {
 			h.logger.Printf("Could not gracefully shutdown the server %+v\n", err)
 		}
+		cancelBase()
 		close(done)
 	}()
 
 	h.logger.Printf("Server starting at port %v ...", h.port)
 
-	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	listen := server.ListenAndServe
+	if h.tls != nil {
+		// the certificate comes from the tls config
+		listen = func() error { return server.ListenAndServeTLS("", "") }
+	}
+	if err := listen(); err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("could not listen on port %v: %v", h.port, err)
 	}
 
@@ -149,6 +224,69 @@ func (h HttpServer) Tracing(nextReuestID func() string) func(http.Handler) http.
 	}
 }
 
+// Namespace serves a request in the namespace of its X-Namespace header or of
+// its /ns/{ns}/ path prefix, the default namespace without them.
+func (h HttpServer) Namespace() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ns := r.Header.Get(contract.NamespaceHeader)
+			if rest, ok := strings.CutPrefix(r.URL.Path, nsPath); ok {
+				name, path, _ := strings.Cut(rest, "/")
+				ns = name
+				r = r.Clone(r.Context())
+				r.URL.Path = "/" + path
+				r.URL.RawPath = ""
+			}
+			next.ServeHTTP(w, r.WithContext(contract.WithNamespace(r.Context(), ns)))
+		})
+	}
+}
+
+// Auth rejects requests without a bearer token accepted by authenticator,
+// a nil authenticator lets every request through.
+func (h HttpServer) Auth(authenticator auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if authenticator == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/healthz" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			token, ok := auth.BearerToken(r.Header.Get("Authorization"))
+			// browsers can not set headers on event streams
+			if !ok && strings.HasPrefix(r.URL.Path, eventsPath) {
+				token = r.URL.Query().Get("access_token")
+			}
+			principal, err := authenticator.Authenticate(token)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				if err := encode(w, http.StatusUnauthorized, NewErrorResult(auth.ErrUnauthenticated)); err != nil {
+					log.Printf("failed to encode error: %s\n", err)
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(access.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+func (h HttpServer) Deadline(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// event streams are long lived
+			if timeout <= 0 || strings.HasPrefix(r.URL.Path, eventsPath) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
 func (h HttpServer) Logging(logger *log.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
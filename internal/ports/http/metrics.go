@@ -0,0 +1,71 @@
+package http
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Metrics records the served requests and serves them on /metrics.
+type Metrics interface {
+	Handler() http.Handler
+	ObserveRequest(route string, method string, status int, d time.Duration)
+}
+
+// Metrics records every request by the route of mux it matches, requests of
+// no route are recorded as unmatched.
+func (h HttpServer) Metrics(metrics Metrics, mux *http.ServeMux) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if metrics == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+
+			route := "unmatched"
+			if _, pattern := mux.Handler(r); pattern != "" {
+				// the method of the pattern is a label of its own
+				_, route, _ = strings.Cut(pattern, " ")
+			}
+			metrics.ObserveRequest(route, r.Method, sw.status, time.Since(start))
+		})
+	}
+}
+
+// statusWriter keeps the status code of a response, event streams flush
+// and hijack it.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("http.ResponseWriter does not implement http.Hijacker")
+	}
+	return hj.Hijack()
+}
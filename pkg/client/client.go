@@ -0,0 +1,169 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+const (
+	DefaultTimeout = 30 * time.Second
+)
+
+// Error is an error answered by a node.
+type Error struct {
+	Status  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("taskstoredb: %d %s", e.Status, e.Message)
+}
+
+type Option func(c *Client)
+
+// WithHttpClient replaces the default client, its timeout has to exceed
+// the long polling wait of Pool.
+func WithHttpClient(h *http.Client) Option {
+	return func(c *Client) {
+		c.http = h
+	}
+}
+
+// WithToken sends token as bearer token, an api key or a jwt.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithNamespace calls the namespace ns instead of the default one.
+func WithNamespace(ns string) Option {
+	return func(c *Client) {
+		c.namespace = ns
+	}
+}
+
+// Client calls any node of the cluster, every node routes a request to the
+// node owning the group. A node that is down is skipped for the next one.
+type Client struct {
+	urls      []string
+	http      *http.Client
+	token     string
+	namespace string
+	cur       atomic.Int32
+}
+
+func New(urls []string, opts ...Option) (*Client, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("urls is empty")
+	}
+	c := &Client{http: &http.Client{Timeout: DefaultTimeout}}
+	for _, u := range urls {
+		if u == "" {
+			return nil, errors.New("url is empty")
+		}
+		c.urls = append(c.urls, strings.TrimRight(u, "/"))
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// do sends the request to the last node that answered and fails over to the
+// next node while nodes are unreachable or unavailable. A request that reached
+// a node before it failed may be applied, failover is at least once.
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	in any,
+	out any,
+) (header http.Header, err error) {
+	var body []byte
+	if in != nil {
+		if body, err = json.Marshal(in); err != nil {
+			return nil, fmt.Errorf("request format error: %v", err)
+		}
+	}
+
+	start := int(c.cur.Load())
+	for i := range c.urls {
+		n := (start + i) % len(c.urls)
+		header, err = c.doNode(ctx, c.urls[n], method, path, body, out)
+		if err == nil {
+			c.cur.Store(int32(n))
+			return header, nil
+		}
+		if ctx.Err() != nil || !failover(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+func (c *Client) doNode(
+	ctx context.Context,
+	url string,
+	method string,
+	path string,
+	body []byte,
+	out any,
+) (http.Header, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url+path, r)
+	if err != nil {
+		return nil, fmt.Errorf("create request error: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.namespace != "" {
+		req.Header.Set(contract.NamespaceHeader, c.namespace)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &Error{Status: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+		var e contract.ErrorResponse
+		if json.NewDecoder(resp.Body).Decode(&e) == nil && e.Error != "" {
+			apiErr.Message = e.Error
+		}
+		return nil, apiErr
+	}
+	if out != nil {
+		if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, fmt.Errorf("response format error: %v", err)
+		}
+	}
+	return resp.Header, nil
+}
+
+func failover(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Status == http.StatusBadGateway || apiErr.Status == http.StatusServiceUnavailable
+	}
+	return true
+}
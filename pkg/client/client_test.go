@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func TestClient_Failover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	downUrl := down.URL
+	down.Close()
+
+	calls := 0
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		json.NewEncoder(w).Encode(map[string]string{"id": "t-K-1"})
+	}))
+	defer up.Close()
+
+	c, err := New([]string{downUrl, up.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		id, err := c.Add(context.Background(), "g", "K", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != "t-K-1" {
+			t.Errorf("not correct id %v", id)
+		}
+	}
+	if calls != 2 || c.cur.Load() != 1 {
+		t.Errorf("not correct failover node %d calls %d", c.cur.Load(), calls)
+	}
+}
+
+func TestClient_Error(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "group is empty"})
+	}))
+	defer srv.Close()
+
+	c, err := New([]string{srv.URL, srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Add(context.Background(), "", "K", nil, nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadRequest || apiErr.Message != "group is empty" {
+		t.Errorf("not correct error %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("client error must not fail over, calls %d", calls)
+	}
+}
+
+func TestClient_Token(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer k1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]string{})
+	}))
+	defer srv.Close()
+
+	c, err := New([]string{srv.URL}, WithToken("k1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Owners(context.Background()); err != nil {
+		t.Errorf("not correct call with token %v", err)
+	}
+	c, _ = New([]string{srv.URL})
+	var apiErr *Error
+	if _, err = c.Owners(context.Background()); !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized {
+		t.Errorf("not correct call without token %v", err)
+	}
+}
+
+func TestClient_Namespace(t *testing.T) {
+	var namespace string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace = r.Header.Get(contract.NamespaceHeader)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]string{})
+	}))
+	defer srv.Close()
+
+	c, err := New([]string{srv.URL}, WithNamespace("team"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Owners(context.Background()); err != nil || namespace != "team" {
+		t.Errorf("not correct call in namespace %q %v", namespace, err)
+	}
+	c, _ = New([]string{srv.URL})
+	if _, err = c.Owners(context.Background()); err != nil || namespace != "" {
+		t.Errorf("not correct call in default namespace %q %v", namespace, err)
+	}
+}
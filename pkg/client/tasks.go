@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/esaseleznev/taskstoredb/internal/contract"
+)
+
+func (c *Client) Add(
+	ctx context.Context,
+	group string,
+	kind string,
+	owner *string,
+	param map[string]string,
+) (id string, err error) {
+	var res contract.AddResponse
+	_, err = c.do(ctx, http.MethodPost, "/task", AddRequest{Group: group, Kind: kind, Owner: owner, Param: param}, &res)
+	return res.Id, err
+}
+
+func (c *Client) AddBatch(ctx context.Context, tasks []AddRequest) (items []BatchItem, err error) {
+	var res contract.BatchResponse
+	_, err = c.do(ctx, http.MethodPost, "/task/batch", contract.BatchAddRequest{Tasks: tasks}, &res)
+	return res.Items, err
+}
+
+func (c *Client) Update(
+	ctx context.Context,
+	group string,
+	id string,
+	status Status,
+	param map[string]string,
+	error *string,
+) (err error) {
+	r := UpdateRequest{Id: id, Group: group, Status: int(status), Param: param, Error: error}
+	_, err = c.do(ctx, http.MethodPatch, "/task", r, nil)
+	return err
+}
+
+func (c *Client) UpdateBatch(ctx context.Context, tasks []UpdateRequest) (items []BatchItem, err error) {
+	var res contract.BatchResponse
+	_, err = c.do(ctx, http.MethodPatch, "/task/batch", contract.BatchUpdateRequest{Tasks: tasks}, &res)
+	return res.Items, err
+}
+
+// Get returns nil when the task does not exist.
+func (c *Client) Get(ctx context.Context, group string, id string) (task *Task, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/task/"+url.PathEscape(id)+"/group/"+url.PathEscape(group), nil, &task)
+	return task, err
+}
+
+func (c *Client) GetFirstInGroup(ctx context.Context, group string) (id string, err error) {
+	var res contract.GetFirstInGroupResponse
+	_, err = c.do(ctx, http.MethodGet, "/task/group/"+url.PathEscape(group), nil, &res)
+	return res.Id, err
+}
+
+// Pool returns the tasks of owner, wait holds the call until a task is available.
+func (c *Client) Pool(ctx context.Context, owner string, kind string, wait time.Duration) (tasks []Task, err error) {
+	if owner == "" || kind == "" {
+		return nil, errors.New("owner and kind are required")
+	}
+	path := "/pool/" + url.PathEscape(owner) + "/kind/" + url.PathEscape(kind)
+	if wait > 0 {
+		path += "?wait=" + url.QueryEscape(wait.String())
+	}
+	_, err = c.do(ctx, http.MethodGet, path, nil, &tasks)
+	return tasks, err
+}
+
+func (c *Client) SearchTask(ctx context.Context, r SearchTaskRequest) (tasks []Task, err error) {
+	r.Internal = false
+	r.AllowPartial = false
+	_, err = c.do(ctx, http.MethodPost, "/task/search", r, &tasks)
+	return tasks, err
+}
+
+func (c *Client) SearchError(ctx context.Context, r SearchTaskRequest) (tasks []Task, err error) {
+	r.Internal = false
+	r.AllowPartial = false
+	_, err = c.do(ctx, http.MethodPost, "/error/search", r, &tasks)
+	return tasks, err
+}
+
+// SearchTaskPartial returns the tasks of the nodes that answered with the status of every node.
+func (c *Client) SearchTaskPartial(ctx context.Context, r SearchTaskRequest) (res PartialTasksResult, err error) {
+	r.Internal = false
+	r.AllowPartial = true
+	_, err = c.do(ctx, http.MethodPost, "/task/search", r, &res)
+	return res, err
+}
+
+func (c *Client) OwnerReg(ctx context.Context, owner string, kinds []string) (err error) {
+	_, err = c.do(ctx, http.MethodPut, "/owner/reg", contract.OwnerRegRequest{Owner: owner, Kinds: kinds}, nil)
+	return err
+}
+
+// OwnerRegTtl registers owner that expires unless it registers again or sends
+// a heartbeat within ttl, the tasks of an expired owner go to the other owners.
+func (c *Client) OwnerRegTtl(ctx context.Context, owner string, kinds []string, ttl time.Duration) (err error) {
+	r := contract.OwnerRegRequest{Owner: owner, Kinds: kinds, Ttl: ttl.Milliseconds()}
+	_, err = c.do(ctx, http.MethodPut, "/owner/reg", r, nil)
+	return err
+}
+
+// Register registers an owner with all the options of the request,
+// the ttl of the request is in milliseconds.
+func (c *Client) Register(ctx context.Context, r OwnerRegRequest) (err error) {
+	r.Internal = false
+	_, err = c.do(ctx, http.MethodPut, "/owner/reg", r, nil)
+	return err
+}
+
+// OwnerHeartbeat keeps owner alive for ttl.
+func (c *Client) OwnerHeartbeat(ctx context.Context, owner string, ttl time.Duration) (err error) {
+	r := contract.OwnerHeartbeatRequest{Owner: owner, Ttl: ttl.Milliseconds()}
+	_, err = c.do(ctx, http.MethodPut, "/owner/heartbeat", r, nil)
+	return err
+}
+
+func (c *Client) OwnerUnReg(ctx context.Context, owner string) (err error) {
+	_, err = c.do(ctx, http.MethodPut, "/owner/unreg", contract.OwnerUnRegRequest{Owner: owner}, nil)
+	return err
+}
+
+// OwnerUnRegKind unregisters owner from kind, an empty kind unregisters every
+// kind. With handoff the tasks of the owner go to the other owners of the kind.
+func (c *Client) OwnerUnRegKind(ctx context.Context, owner string, kind string, handoff bool) (err error) {
+	r := contract.OwnerUnRegRequest{Owner: owner, Kind: kind, Handoff: handoff}
+	_, err = c.do(ctx, http.MethodPut, "/owner/unreg", r, nil)
+	return err
+}
+
+func (c *Client) SearchDeleteTask(ctx context.Context, condition *Condition, kind *string, size *uint) (err error) {
+	r := SearchTaskRequest{Condition: condition, Kind: kind, Size: size}
+	_, err = c.do(ctx, http.MethodPost, "/task/search/delete", r, nil)
+	return err
+}
+
+func (c *Client) SearchDeleteError(ctx context.Context, condition *Condition, kind *string, size *uint) (err error) {
+	r := SearchTaskRequest{Condition: condition, Kind: kind, Size: size}
+	_, err = c.do(ctx, http.MethodPost, "/error/search/delete", r, nil)
+	return err
+}
+
+func (c *Client) SearchUpdateTask(
+	ctx context.Context,
+	up TaskUpdate,
+	condition *Condition,
+	kind *string,
+	size *uint,
+) (err error) {
+	r := contract.SearchUpdateTaskRequest{Up: up, Condition: condition, Kind: kind, Size: size}
+	_, err = c.do(ctx, http.MethodPost, "/task/search/update", r, nil)
+	return err
+}
+
+func (c *Client) SearchUpdateError(
+	ctx context.Context,
+	up TaskUpdate,
+	condition *Condition,
+	kind *string,
+	size *uint,
+) (err error) {
+	r := contract.SearchUpdateTaskRequest{Up: up, Condition: condition, Kind: kind, Size: size}
+	_, err = c.do(ctx, http.MethodPost, "/error/search/update", r, nil)
+	return err
+}
+
+func (c *Client) Owners(ctx context.Context) (owners []OwnerKinds, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/owner", nil, &owners)
+	return owners, err
+}
+
+// KindOwners lists the owners new tasks of every kind are assigned to.
+func (c *Client) KindOwners(ctx context.Context) (kinds []KindOwners, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/kind/owners", nil, &kinds)
+	return kinds, err
+}
+
+// Status reports the node answering, its cluster nodes and raft state.
+func (c *Client) Status(ctx context.Context) (info NodeInfo, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/status", nil, &info)
+	return info, err
+}
+
+// StatusOf asks the node at url only, without failover.
+func (c *Client) StatusOf(ctx context.Context, url string) (info NodeInfo, err error) {
+	_, err = c.doNode(ctx, strings.TrimRight(url, "/"), http.MethodGet, "/status", nil, &info)
+	return info, err
+}
+
+// Pause keeps the tasks of a kind or a group out of the pool, set one of them.
+func (c *Client) Pause(ctx context.Context, kind string, group string) (err error) {
+	_, err = c.do(ctx, http.MethodPut, "/pause", contract.PauseRequest{Kind: kind, Group: group}, nil)
+	return err
+}
+
+func (c *Client) Resume(ctx context.Context, kind string, group string) (err error) {
+	_, err = c.do(ctx, http.MethodPut, "/resume", contract.PauseRequest{Kind: kind, Group: group}, nil)
+	return err
+}
+
+func (c *Client) Paused(ctx context.Context) (pauses []Pause, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/pause", nil, &pauses)
+	return pauses, err
+}
+
+func (c *Client) KindConfigSet(ctx context.Context, config KindConfig) (err error) {
+	_, err = c.do(ctx, http.MethodPut, "/kind/config", contract.KindConfigRequest{KindConfig: config}, nil)
+	return err
+}
+
+func (c *Client) KindConfigs(ctx context.Context) (configs []KindConfig, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/kind/config", nil, &configs)
+	return configs, err
+}
+
+// InFlight counts the SCHEDULED tasks of kind in the cluster.
+func (c *Client) InFlight(ctx context.Context, kind string) (n int, err error) {
+	var res contract.InFlightResponse
+	_, err = c.do(ctx, http.MethodGet, "/kind/"+url.PathEscape(kind)+"/inflight", nil, &res)
+	return res.Count, err
+}
+
+// NamespaceCreate creates a namespace or changes its quotas, 0 is unlimited.
+func (c *Client) NamespaceCreate(ctx context.Context, ns Namespace) (err error) {
+	_, err = c.do(ctx, http.MethodPut, "/namespace", contract.NamespaceRequest{Namespace: ns}, nil)
+	return err
+}
+
+// NamespaceDrop drops a namespace with all its tasks.
+func (c *Client) NamespaceDrop(ctx context.Context, name string) (err error) {
+	_, err = c.do(ctx, http.MethodDelete, "/namespace/"+url.PathEscape(name), nil, nil)
+	return err
+}
+
+// Namespaces lists the namespaces with their usage in the cluster.
+func (c *Client) Namespaces(ctx context.Context) (namespaces []Namespace, err error) {
+	_, err = c.do(ctx, http.MethodGet, "/namespace", nil, &namespaces)
+	return namespaces, err
+}
@@ -0,0 +1,60 @@
+package client
+
+import "github.com/esaseleznev/taskstoredb/internal/contract"
+
+// the wire types of the http api
+type (
+	Task               = contract.Task
+	Status             = contract.Status
+	Condition          = contract.Condition
+	Operation          = contract.Operation
+	Operator           = contract.Operator
+	LogicalOperator    = contract.LogicalOperator
+	AddRequest         = contract.AddRequest
+	UpdateRequest      = contract.UpdateRequest
+	BatchItem          = contract.BatchItem
+	SearchTaskRequest  = contract.SearchTaskRequest
+	TaskUpdate         = contract.TaskUpdate
+	NodeStatus         = contract.NodeStatus
+	PartialTasksResult = contract.PartialTasksResponse
+	OwnerKinds         = contract.OwnerKinds
+	KindOwners         = contract.KindOwners
+	RaftStatus         = contract.RaftStatus
+	NodeInfo           = contract.StatusResponse
+	Pause              = contract.Pause
+	KindConfig         = contract.KindConfig
+	Strategy           = contract.Strategy
+	OwnerRegRequest    = contract.OwnerRegRequest
+	Namespace          = contract.Namespace
+	NamespaceUsage     = contract.NamespaceUsage
+)
+
+const (
+	VIRGIN    = contract.VIRGIN
+	SCHEDULED = contract.SCHEDULED
+	COMPLETED = contract.COMPLETED
+	FAILED    = contract.FAILED
+	CANCELLED = contract.CANCELLED
+	PAUSED    = contract.PAUSED
+)
+
+const (
+	ROUND_ROBIN       = contract.ROUND_ROBIN
+	WEIGHTED          = contract.WEIGHTED
+	LEAST_OUTSTANDING = contract.LEAST_OUTSTANDING
+	HASH              = contract.HASH
+	UNASSIGNED        = contract.UNASSIGNED
+)
+
+const (
+	Equal              = contract.Equal
+	NotEqual           = contract.NotEqual
+	LessThan           = contract.LessThan
+	LessThanOrEqual    = contract.LessThanOrEqual
+	GreaterThan        = contract.GreaterThan
+	GreaterThanOrEqual = contract.GreaterThanOrEqual
+	Contains           = contract.Contains
+
+	And = contract.And
+	Or  = contract.Or
+)
@@ -0,0 +1,310 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultConcurrency = 1
+	DefaultWait        = 20 * time.Second
+	DefaultIdle        = time.Second
+	DefaultTtl         = 30 * time.Second
+	reportAttempts     = 5
+	maxBackoff         = 30 * time.Second
+)
+
+// Handler processes a task, a nil error completes the task with param,
+// nil param keeps the params of the task. An error fails the task.
+type Handler func(ctx context.Context, task Task) (param map[string]string, err error)
+
+type WorkerOption func(w *Worker)
+
+// WithConcurrency limits handlers running at once over all kinds.
+func WithConcurrency(n int) WorkerOption {
+	return func(w *Worker) {
+		if n > 0 {
+			w.concurrency = n
+		}
+	}
+}
+
+// WithWait sets the long polling wait of a pool call.
+func WithWait(wait time.Duration) WorkerOption {
+	return func(w *Worker) {
+		w.wait = wait
+	}
+}
+
+// WithTtl sets the ttl of the owner, the worker sends a heartbeat three times
+// per ttl. The tasks of a worker that stops beating go to the other owners,
+// 0 keeps the owner registered until Run returns.
+func WithTtl(ttl time.Duration) WorkerOption {
+	return func(w *Worker) {
+		w.ttl = ttl
+	}
+}
+
+// WithWeight sets the share of the tasks the worker gets relative to the
+// other owners of a kind with a WEIGHTED, LEAST_OUTSTANDING or HASH strategy.
+func WithWeight(weight int) WorkerOption {
+	return func(w *Worker) {
+		w.weight = weight
+	}
+}
+
+func WithLogger(logger *log.Logger) WorkerOption {
+	return func(w *Worker) {
+		w.logger = logger
+	}
+}
+
+// Worker registers owner for kinds, pools its tasks and reports them
+// COMPLETED or FAILED. Tasks stay with the owner until reported so a task
+// is processed at least once.
+type Worker struct {
+	client      *Client
+	owner       string
+	kinds       []string
+	handler     Handler
+	concurrency int
+	wait        time.Duration
+	ttl         time.Duration
+	weight      int
+	logger      *log.Logger
+
+	mu       sync.Mutex
+	inflight map[string]bool
+	slots    chan struct{}
+	// signalled when a handler finishes so pooling does not spin on own tasks
+	finished chan struct{}
+}
+
+func NewWorker(
+	client *Client,
+	owner string,
+	kinds []string,
+	handler Handler,
+	opts ...WorkerOption,
+) (*Worker, error) {
+	if client == nil {
+		return nil, errors.New("nil client")
+	}
+	if owner == "" {
+		return nil, errors.New("owner is empty")
+	}
+	if len(kinds) == 0 {
+		return nil, errors.New("kinds is empty")
+	}
+	if handler == nil {
+		return nil, errors.New("nil handler")
+	}
+
+	w := &Worker{
+		client:      client,
+		owner:       owner,
+		kinds:       kinds,
+		handler:     handler,
+		concurrency: DefaultConcurrency,
+		wait:        DefaultWait,
+		ttl:         DefaultTtl,
+		logger:      log.Default(),
+		inflight:    make(map[string]bool),
+		finished:    make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	w.slots = make(chan struct{}, w.concurrency)
+	return w, nil
+}
+
+// Run works until ctx is done, then waits for running handlers and
+// unregisters the owner handing its remaining tasks to the other owners.
+func (w *Worker) Run(ctx context.Context) error {
+	if err := w.register(ctx); err != nil {
+		return fmt.Errorf("owner registration error: %w", err)
+	}
+
+	var handlers sync.WaitGroup
+	var pools sync.WaitGroup
+	if w.ttl > 0 {
+		beatCtx, stopBeat := context.WithCancel(ctx)
+		defer stopBeat()
+		go w.heartbeat(beatCtx)
+	}
+	for _, kind := range w.kinds {
+		pools.Add(1)
+		go func() {
+			defer pools.Done()
+			w.pool(ctx, kind, &handlers)
+		}()
+	}
+	pools.Wait()
+	handlers.Wait()
+
+	unregCtx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	if err := w.client.OwnerUnRegKind(unregCtx, w.owner, "", true); err != nil {
+		return fmt.Errorf("owner unregistration error: %w", err)
+	}
+	return nil
+}
+
+func (w *Worker) register(ctx context.Context) error {
+	return w.client.Register(ctx, OwnerRegRequest{
+		Owner:  w.owner,
+		Kinds:  w.kinds,
+		Ttl:    w.ttl.Milliseconds(),
+		Weight: w.weight,
+	})
+}
+
+func (w *Worker) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(w.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// registered again so an owner expired by a partition comes back
+			err := w.register(ctx)
+			if err != nil && ctx.Err() == nil {
+				w.logger.Printf("owner %v heartbeat error: %v", w.owner, err)
+			}
+		}
+	}
+}
+
+func (w *Worker) pool(ctx context.Context, kind string, handlers *sync.WaitGroup) {
+	backoff := time.Duration(0)
+	for ctx.Err() == nil {
+		tasks, err := w.client.Pool(ctx, w.owner, kind, w.wait)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			backoff = min(max(2*backoff, time.Second), maxBackoff)
+			w.logger.Printf("pool %v error, retry in %v: %v", kind, backoff, err)
+			sleep(ctx, backoff)
+			continue
+		}
+		backoff = 0
+
+		started := 0
+		for _, task := range tasks {
+			if !w.lease(task.Id) {
+				continue
+			}
+			select {
+			case w.slots <- struct{}{}:
+			case <-ctx.Done():
+				w.release(task.Id)
+				return
+			}
+			started++
+			handlers.Add(1)
+			go func() {
+				defer handlers.Done()
+				w.process(ctx, task)
+			}()
+		}
+
+		// every pooled task is running, wait for one to finish
+		if started == 0 {
+			select {
+			case <-w.finished:
+			case <-time.After(DefaultIdle):
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+func (w *Worker) process(ctx context.Context, task Task) {
+	defer func() {
+		<-w.slots
+		w.release(task.Id)
+		select {
+		case w.finished <- struct{}{}:
+		default:
+		}
+	}()
+
+	param, err := w.handle(ctx, task)
+	if ctx.Err() != nil && err != nil {
+		// shutting down, the task stays with the owner
+		return
+	}
+
+	status := COMPLETED
+	var msg *string
+	if err != nil {
+		status = FAILED
+		s := err.Error()
+		msg = &s
+	}
+	if param == nil {
+		param = task.Param
+	}
+
+	// reported even while shutting down, the work is done, but a shutdown ends
+	// the backoff and no attempt outlives the report deadline
+	reportCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DefaultTimeout)
+	defer cancel()
+	deadline, _ := reportCtx.Deadline()
+	backoffCtx, cancelBackoff := context.WithDeadline(ctx, deadline)
+	defer cancelBackoff()
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		err = w.client.Update(reportCtx, task.Group, task.Id, status, param, msg)
+		if err == nil {
+			return
+		}
+		if attempt == reportAttempts || backoffCtx.Err() != nil {
+			w.logger.Printf("report task %v error: %v", task.Id, err)
+			return
+		}
+		sleep(backoffCtx, backoff)
+		backoff *= 2
+	}
+}
+
+func (w *Worker) handle(ctx context.Context, task Task) (param map[string]string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panic: %v", r)
+		}
+	}()
+	return w.handler(ctx, task)
+}
+
+func (w *Worker) lease(id string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.inflight[id] {
+		return false
+	}
+	w.inflight[id] = true
+	return true
+}
+
+func (w *Worker) release(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.inflight, id)
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeStore struct {
+	mu       sync.Mutex
+	tasks    map[string]Task
+	reported map[string]UpdateRequest
+	unreg    bool
+	// reports fail with a server error when set
+	down    bool
+	reports int
+}
+
+func (s *fakeStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPut && r.URL.Path == "/owner/reg":
+	case r.Method == http.MethodPut && r.URL.Path == "/owner/unreg":
+		s.unreg = true
+	case r.Method == http.MethodGet:
+		tasks := []Task{}
+		for _, t := range s.tasks {
+			tasks = append(tasks, t)
+		}
+		json.NewEncoder(w).Encode(tasks)
+	case r.Method == http.MethodPatch && s.down:
+		s.reports++
+		w.WriteHeader(http.StatusInternalServerError)
+	case r.Method == http.MethodPatch:
+		var u UpdateRequest
+		json.NewDecoder(r.Body).Decode(&u)
+		if _, ok := s.reported[u.Id]; ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		s.reported[u.Id] = u
+		delete(s.tasks, u.Id)
+	}
+}
+
+func TestWorker_Run(t *testing.T) {
+	store := &fakeStore{
+		tasks: map[string]Task{
+			"t-K-1": {Id: "t-K-1", Kind: "K", Group: "a"},
+			"t-K-2": {Id: "t-K-2", Kind: "K", Group: "b"},
+			"t-K-3": {Id: "t-K-3", Kind: "K", Group: "c"},
+		},
+		reported: map[string]UpdateRequest{},
+	}
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+
+	c, err := New([]string{srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var running, peak atomic.Int32
+	w, err := NewWorker(c, "w1", []string{"K"},
+		func(ctx context.Context, task Task) (map[string]string, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			if n > peak.Load() {
+				peak.Store(n)
+			}
+			time.Sleep(20 * time.Millisecond)
+			if task.Id == "t-K-3" {
+				return nil, errors.New("bad task")
+			}
+			return map[string]string{"done": "1"}, nil
+		},
+		WithConcurrency(2),
+		WithWait(0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if err = w.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if len(store.reported) != 3 {
+		t.Fatalf("not correct count reported tasks %d", len(store.reported))
+	}
+	if u := store.reported["t-K-1"]; u.Status != int(COMPLETED) || u.Param["done"] != "1" {
+		t.Errorf("not correct completed report %+v", u)
+	}
+	if u := store.reported["t-K-3"]; u.Status != int(FAILED) || u.Error == nil || *u.Error != "bad task" {
+		t.Errorf("not correct failed report %+v", u)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("concurrency limit exceeded %d", peak.Load())
+	}
+	if !store.unreg {
+		t.Errorf("owner not unregistered on shutdown")
+	}
+}
+
+func TestWorker_RunShutdownReport(t *testing.T) {
+	store := &fakeStore{
+		tasks:    map[string]Task{"t-K-1": {Id: "t-K-1", Kind: "K", Group: "a"}},
+		reported: map[string]UpdateRequest{},
+		down:     true,
+	}
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+
+	c, err := New([]string{srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var handled atomic.Bool
+	w, err := NewWorker(c, "w1", []string{"K"},
+		func(ctx context.Context, task Task) (map[string]string, error) {
+			handled.Store(true)
+			return nil, nil
+		},
+		WithWait(0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err = w.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// a shutdown ends the backoff of a failing report after one more attempt
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("not correct shutdown time with failing reports %v", d)
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if !handled.Load() || store.reports != 2 {
+		t.Errorf("not correct count report attempts %d", store.reports)
+	}
+}
@@ -0,0 +1,2310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: taskstore/v1/taskstore.proto
+
+package taskstorepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_VIRGIN             Status = 1
+	Status_SCHEDULED          Status = 2
+	Status_COMPLETED          Status = 3
+	Status_FAILED             Status = 4
+	Status_CANCELLED          Status = 5
+	Status_PAUSED             Status = 6
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "VIRGIN",
+		2: "SCHEDULED",
+		3: "COMPLETED",
+		4: "FAILED",
+		5: "CANCELLED",
+		6: "PAUSED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"VIRGIN":             1,
+		"SCHEDULED":          2,
+		"COMPLETED":          3,
+		"FAILED":             4,
+		"CANCELLED":          5,
+		"PAUSED":             6,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_taskstore_v1_taskstore_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_taskstore_v1_taskstore_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{0}
+}
+
+// Strategy is how new tasks of a kind get their owner.
+type Strategy int32
+
+const (
+	// ROUND_ROBIN
+	Strategy_STRATEGY_UNSPECIFIED Strategy = 0
+	Strategy_ROUND_ROBIN          Strategy = 1
+	// round robin in proportion to the owner weights
+	Strategy_WEIGHTED Strategy = 2
+	// the owner with the fewest VIRGIN and SCHEDULED tasks per weight
+	Strategy_LEAST_OUTSTANDING Strategy = 3
+	// the same owner for the same value of the hash param
+	Strategy_HASH Strategy = 4
+	// tasks are added without an owner
+	Strategy_UNASSIGNED Strategy = 5
+)
+
+// Enum value maps for Strategy.
+var (
+	Strategy_name = map[int32]string{
+		0: "STRATEGY_UNSPECIFIED",
+		1: "ROUND_ROBIN",
+		2: "WEIGHTED",
+		3: "LEAST_OUTSTANDING",
+		4: "HASH",
+		5: "UNASSIGNED",
+	}
+	Strategy_value = map[string]int32{
+		"STRATEGY_UNSPECIFIED": 0,
+		"ROUND_ROBIN":          1,
+		"WEIGHTED":             2,
+		"LEAST_OUTSTANDING":    3,
+		"HASH":                 4,
+		"UNASSIGNED":           5,
+	}
+)
+
+func (x Strategy) Enum() *Strategy {
+	p := new(Strategy)
+	*p = x
+	return p
+}
+
+func (x Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_taskstore_v1_taskstore_proto_enumTypes[1].Descriptor()
+}
+
+func (Strategy) Type() protoreflect.EnumType {
+	return &file_taskstore_v1_taskstore_proto_enumTypes[1]
+}
+
+func (x Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Strategy.Descriptor instead.
+func (Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{1}
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{0}
+}
+
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Owner         *string                `protobuf:"bytes,4,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Status        Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=taskstoredb.v1.Status" json:"status,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,6,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ts            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	Error         *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Task) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Task) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Task) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+func (x *Task) GetTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ts
+	}
+	return nil
+}
+
+func (x *Task) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type TaskUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          *string                `protobuf:"bytes,1,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Group         *string                `protobuf:"bytes,2,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Owner         *string                `protobuf:"bytes,3,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Status        *Status                `protobuf:"varint,4,opt,name=status,proto3,enum=taskstoredb.v1.Status,oneof" json:"status,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,5,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskUpdate) Reset() {
+	*x = TaskUpdate{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUpdate) ProtoMessage() {}
+
+func (x *TaskUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUpdate.ProtoReflect.Descriptor instead.
+func (*TaskUpdate) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskUpdate) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetStatus() Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *TaskUpdate) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type Operation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// =, !=, <, <=, >, >=
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// id, kind, group, owner, status, ts, error or param.<name>
+	Field         string          `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         *structpb.Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Operation) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Operation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Operation) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// AND by default or OR
+	LogicalOperator *string      `protobuf:"bytes,1,opt,name=logical_operator,json=logicalOperator,proto3,oneof" json:"logical_operator,omitempty"`
+	Operations      []*Operation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	Conditions      []*Condition `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Condition) GetLogicalOperator() string {
+	if x != nil && x.LogicalOperator != nil {
+		return *x.LogicalOperator
+	}
+	return ""
+}
+
+func (x *Condition) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *Condition) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type AddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Owner         *string                `protobuf:"bytes,3,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,4,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRequest) Reset() {
+	*x = AddRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRequest) ProtoMessage() {}
+
+func (x *AddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRequest.ProtoReflect.Descriptor instead.
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *AddRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddRequest) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *AddRequest) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+type AddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResponse) Reset() {
+	*x = AddResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResponse) ProtoMessage() {}
+
+func (x *AddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResponse.ProtoReflect.Descriptor instead.
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Status        Status                 `protobuf:"varint,3,opt,name=status,proto3,enum=taskstoredb.v1.Status" json:"status,omitempty"`
+	Param         map[string]string      `protobuf:"bytes,4,rep,name=param,proto3" json:"param,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateRequest) GetParam() map[string]string {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3,oneof" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type GetFirstInGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirstInGroupRequest) Reset() {
+	*x = GetFirstInGroupRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirstInGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirstInGroupRequest) ProtoMessage() {}
+
+func (x *GetFirstInGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirstInGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetFirstInGroupRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetFirstInGroupRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type GetFirstInGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirstInGroupResponse) Reset() {
+	*x = GetFirstInGroupResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirstInGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirstInGroupResponse) ProtoMessage() {}
+
+func (x *GetFirstInGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirstInGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetFirstInGroupResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFirstInGroupResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PoolRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Owner        string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Kind         string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	AllowPartial bool                   `protobuf:"varint,3,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	// long polling, hold the pool until a task is available
+	Wait          *durationpb.Duration `protobuf:"bytes,4,opt,name=wait,proto3" json:"wait,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolRequest) Reset() {
+	*x = PoolRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolRequest) ProtoMessage() {}
+
+func (x *PoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolRequest.ProtoReflect.Descriptor instead.
+func (*PoolRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PoolRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PoolRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PoolRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *PoolRequest) GetWait() *durationpb.Duration {
+	if x != nil {
+		return x.Wait
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Condition     *Condition             `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Size          *uint64                `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	AllowPartial  bool                   `protobuf:"varint,4,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchRequest) GetCondition() *Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSize() uint64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+type SearchUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Up            *TaskUpdate            `protobuf:"bytes,1,opt,name=up,proto3" json:"up,omitempty"`
+	Condition     *Condition             `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	Kind          *string                `protobuf:"bytes,3,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Size          *uint64                `protobuf:"varint,4,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUpdateRequest) Reset() {
+	*x = SearchUpdateRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUpdateRequest) ProtoMessage() {}
+
+func (x *SearchUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SearchUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchUpdateRequest) GetUp() *TaskUpdate {
+	if x != nil {
+		return x.Up
+	}
+	return nil
+}
+
+func (x *SearchUpdateRequest) GetCondition() *Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *SearchUpdateRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *SearchUpdateRequest) GetSize() uint64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+type OwnerRegRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Owner string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Kinds []string               `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// the owner expires and its tasks go to the other owners of the kinds
+	// unless it registers again or sends a heartbeat in time, unset never expires
+	Ttl *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// share of the tasks the owner gets relative to the other owners of a kind,
+	// unset is 1
+	Weight        int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerRegRequest) Reset() {
+	*x = OwnerRegRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerRegRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerRegRequest) ProtoMessage() {}
+
+func (x *OwnerRegRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerRegRequest.ProtoReflect.Descriptor instead.
+func (*OwnerRegRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OwnerRegRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerRegRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *OwnerRegRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *OwnerRegRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type OwnerHeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerHeartbeatRequest) Reset() {
+	*x = OwnerHeartbeatRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerHeartbeatRequest) ProtoMessage() {}
+
+func (x *OwnerHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*OwnerHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OwnerHeartbeatRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerHeartbeatRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type OwnerUnRegRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Owner string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// unset unregisters every kind of the owner
+	Kind *string `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	// hands the tasks of the owner over to the other owners of the kind
+	Handoff       bool `protobuf:"varint,3,opt,name=handoff,proto3" json:"handoff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerUnRegRequest) Reset() {
+	*x = OwnerUnRegRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerUnRegRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerUnRegRequest) ProtoMessage() {}
+
+func (x *OwnerUnRegRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerUnRegRequest.ProtoReflect.Descriptor instead.
+func (*OwnerUnRegRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OwnerUnRegRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerUnRegRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *OwnerUnRegRequest) GetHandoff() bool {
+	if x != nil {
+		return x.Handoff
+	}
+	return false
+}
+
+// PauseRequest names a kind or a group.
+type PauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRequest) Reset() {
+	*x = PauseRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRequest) ProtoMessage() {}
+
+func (x *PauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PauseRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PauseRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type Pause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Ts            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pause) Reset() {
+	*x = Pause{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pause) ProtoMessage() {}
+
+func (x *Pause) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pause.ProtoReflect.Descriptor instead.
+func (*Pause) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Pause) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Pause) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Pause) GetTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ts
+	}
+	return nil
+}
+
+type PausedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pauses        []*Pause               `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PausedResponse) Reset() {
+	*x = PausedResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausedResponse) ProtoMessage() {}
+
+func (x *PausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausedResponse.ProtoReflect.Descriptor instead.
+func (*PausedResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PausedResponse) GetPauses() []*Pause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+type KindConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// only the head task of each group is pooled
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// max count of SCHEDULED tasks in the cluster, 0 is unlimited
+	MaxInFlight int32 `protobuf:"varint,3,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
+	// max count of tasks scheduled per second, 0 is unlimited
+	Rate     float64  `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Strategy Strategy `protobuf:"varint,5,opt,name=strategy,proto3,enum=taskstoredb.v1.Strategy" json:"strategy,omitempty"`
+	// param of the task HASH assigns by, tasks without it go round robin
+	HashParam string `protobuf:"bytes,6,opt,name=hash_param,json=hashParam,proto3" json:"hash_param,omitempty"`
+	// an owner with an empty pool takes VIRGIN tasks of the owner with the most
+	// of them, the pool marks the tasks it hands out SCHEDULED
+	Steal         bool `protobuf:"varint,7,opt,name=steal,proto3" json:"steal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindConfig) Reset() {
+	*x = KindConfig{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindConfig) ProtoMessage() {}
+
+func (x *KindConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindConfig.ProtoReflect.Descriptor instead.
+func (*KindConfig) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KindConfig) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KindConfig) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *KindConfig) GetMaxInFlight() int32 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+func (x *KindConfig) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *KindConfig) GetStrategy() Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Strategy_STRATEGY_UNSPECIFIED
+}
+
+func (x *KindConfig) GetHashParam() string {
+	if x != nil {
+		return x.HashParam
+	}
+	return ""
+}
+
+func (x *KindConfig) GetSteal() bool {
+	if x != nil {
+		return x.Steal
+	}
+	return false
+}
+
+type InFlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InFlightRequest) Reset() {
+	*x = InFlightRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightRequest) ProtoMessage() {}
+
+func (x *InFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InFlightRequest.ProtoReflect.Descriptor instead.
+func (*InFlightRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InFlightRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type InFlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InFlightResponse) Reset() {
+	*x = InFlightResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InFlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightResponse) ProtoMessage() {}
+
+func (x *InFlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InFlightResponse.ProtoReflect.Descriptor instead.
+func (*InFlightResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InFlightResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type KindConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*KindConfig          `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindConfigsResponse) Reset() {
+	*x = KindConfigsResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindConfigsResponse) ProtoMessage() {}
+
+func (x *KindConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindConfigsResponse.ProtoReflect.Descriptor instead.
+func (*KindConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *KindConfigsResponse) GetConfigs() []*KindConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+// KindOwners are the owners new tasks of the kind are assigned to by the strategy.
+type KindOwners struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Kind   string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Owners []string               `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+	// weight of each owner
+	Weights       []int32  `protobuf:"varint,3,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	Strategy      Strategy `protobuf:"varint,4,opt,name=strategy,proto3,enum=taskstoredb.v1.Strategy" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindOwners) Reset() {
+	*x = KindOwners{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindOwners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindOwners) ProtoMessage() {}
+
+func (x *KindOwners) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindOwners.ProtoReflect.Descriptor instead.
+func (*KindOwners) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KindOwners) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KindOwners) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *KindOwners) GetWeights() []int32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *KindOwners) GetStrategy() Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Strategy_STRATEGY_UNSPECIFIED
+}
+
+type KindOwnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kinds         []*KindOwners          `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindOwnersResponse) Reset() {
+	*x = KindOwnersResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindOwnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindOwnersResponse) ProtoMessage() {}
+
+func (x *KindOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindOwnersResponse.ProtoReflect.Descriptor instead.
+func (*KindOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *KindOwnersResponse) GetKinds() []*KindOwners {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type Namespace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max count of tasks in the cluster, 0 is unlimited
+	MaxTasks int64 `protobuf:"varint,2,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+	// max size of tasks in the cluster, 0 is unlimited
+	MaxBytes int64 `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// usage in the cluster
+	Tasks         int64 `protobuf:"varint,4,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Bytes         int64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetMaxTasks() int64 {
+	if x != nil {
+		return x.MaxTasks
+	}
+	return 0
+}
+
+func (x *Namespace) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Namespace) GetTasks() int64 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *Namespace) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type NamespaceDropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceDropRequest) Reset() {
+	*x = NamespaceDropRequest{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceDropRequest) ProtoMessage() {}
+
+func (x *NamespaceDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceDropRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceDropRequest) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NamespaceDropRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NamespacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces    []*Namespace           `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespacesResponse) Reset() {
+	*x = NamespacesResponse{}
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacesResponse) ProtoMessage() {}
+
+func (x *NamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskstore_v1_taskstore_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacesResponse.ProtoReflect.Descriptor instead.
+func (*NamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_taskstore_v1_taskstore_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NamespacesResponse) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+var File_taskstore_v1_taskstore_proto protoreflect.FileDescriptor
+
+const file_taskstore_v1_taskstore_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctaskstore/v1/taskstore.proto\x12\x0etaskstoredb.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
+	"\x05Empty\"\xd7\x02\n" +
+	"\x04Task\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\x12\x19\n" +
+	"\x05owner\x18\x04 \x01(\tH\x00R\x05owner\x88\x01\x01\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.taskstoredb.v1.StatusR\x06status\x125\n" +
+	"\x05param\x18\x06 \x03(\v2\x1f.taskstoredb.v1.Task.ParamEntryR\x05param\x12*\n" +
+	"\x02ts\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02ts\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x01R\x05error\x88\x01\x01\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_ownerB\b\n" +
+	"\x06_error\"\xd4\x02\n" +
+	"\n" +
+	"TaskUpdate\x12\x17\n" +
+	"\x04kind\x18\x01 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x19\n" +
+	"\x05group\x18\x02 \x01(\tH\x01R\x05group\x88\x01\x01\x12\x19\n" +
+	"\x05owner\x18\x03 \x01(\tH\x02R\x05owner\x88\x01\x01\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.taskstoredb.v1.StatusH\x03R\x06status\x88\x01\x01\x12;\n" +
+	"\x05param\x18\x05 \x03(\v2%.taskstoredb.v1.TaskUpdate.ParamEntryR\x05param\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x04R\x05error\x88\x01\x01\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_kindB\b\n" +
+	"\x06_groupB\b\n" +
+	"\x06_ownerB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_error\"k\n" +
+	"\tOperation\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12,\n" +
+	"\x05value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xc6\x01\n" +
+	"\tCondition\x12.\n" +
+	"\x10logical_operator\x18\x01 \x01(\tH\x00R\x0flogicalOperator\x88\x01\x01\x129\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\v2\x19.taskstoredb.v1.OperationR\n" +
+	"operations\x129\n" +
+	"\n" +
+	"conditions\x18\x03 \x03(\v2\x19.taskstoredb.v1.ConditionR\n" +
+	"conditionsB\x13\n" +
+	"\x11_logical_operator\"\xd2\x01\n" +
+	"\n" +
+	"AddRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x19\n" +
+	"\x05owner\x18\x03 \x01(\tH\x00R\x05owner\x88\x01\x01\x12;\n" +
+	"\x05param\x18\x04 \x03(\v2%.taskstoredb.v1.AddRequest.ParamEntryR\x05param\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_owner\"\x1d\n" +
+	"\vAddResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x84\x02\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.taskstoredb.v1.StatusR\x06status\x12>\n" +
+	"\x05param\x18\x04 \x03(\v2(.taskstoredb.v1.UpdateRequest.ParamEntryR\x05param\x12\x19\n" +
+	"\x05error\x18\x05 \x01(\tH\x00R\x05error\x88\x01\x01\x1a8\n" +
+	"\n" +
+	"ParamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_error\"2\n" +
+	"\n" +
+	"GetRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"E\n" +
+	"\vGetResponse\x12-\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.taskstoredb.v1.TaskH\x00R\x04task\x88\x01\x01B\a\n" +
+	"\x05_task\".\n" +
+	"\x16GetFirstInGroupRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\")\n" +
+	"\x17GetFirstInGroupResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x01\n" +
+	"\vPoolRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12#\n" +
+	"\rallow_partial\x18\x03 \x01(\bR\fallowPartial\x12-\n" +
+	"\x04wait\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x04wait\"\xb1\x01\n" +
+	"\rSearchRequest\x127\n" +
+	"\tcondition\x18\x01 \x01(\v2\x19.taskstoredb.v1.ConditionR\tcondition\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x03 \x01(\x04H\x01R\x04size\x88\x01\x01\x12#\n" +
+	"\rallow_partial\x18\x04 \x01(\bR\fallowPartialB\a\n" +
+	"\x05_kindB\a\n" +
+	"\x05_size\"\xbe\x01\n" +
+	"\x13SearchUpdateRequest\x12*\n" +
+	"\x02up\x18\x01 \x01(\v2\x1a.taskstoredb.v1.TaskUpdateR\x02up\x127\n" +
+	"\tcondition\x18\x02 \x01(\v2\x19.taskstoredb.v1.ConditionR\tcondition\x12\x17\n" +
+	"\x04kind\x18\x03 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x04 \x01(\x04H\x01R\x04size\x88\x01\x01B\a\n" +
+	"\x05_kindB\a\n" +
+	"\x05_size\"\x82\x01\n" +
+	"\x0fOwnerRegRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x14\n" +
+	"\x05kinds\x18\x02 \x03(\tR\x05kinds\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\"Z\n" +
+	"\x15OwnerHeartbeatRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"e\n" +
+	"\x11OwnerUnRegRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x18\n" +
+	"\ahandoff\x18\x03 \x01(\bR\ahandoffB\a\n" +
+	"\x05_kind\"8\n" +
+	"\fPauseRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\"]\n" +
+	"\x05Pause\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12*\n" +
+	"\x02ts\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02ts\"?\n" +
+	"\x0ePausedResponse\x12-\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x15.taskstoredb.v1.PauseR\x06pauses\"\xdd\x01\n" +
+	"\n" +
+	"KindConfig\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\"\n" +
+	"\rmax_in_flight\x18\x03 \x01(\x05R\vmaxInFlight\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x124\n" +
+	"\bstrategy\x18\x05 \x01(\x0e2\x18.taskstoredb.v1.StrategyR\bstrategy\x12\x1d\n" +
+	"\n" +
+	"hash_param\x18\x06 \x01(\tR\thashParam\x12\x14\n" +
+	"\x05steal\x18\a \x01(\bR\x05steal\"%\n" +
+	"\x0fInFlightRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"(\n" +
+	"\x10InFlightResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"K\n" +
+	"\x13KindConfigsResponse\x124\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x1a.taskstoredb.v1.KindConfigR\aconfigs\"\x88\x01\n" +
+	"\n" +
+	"KindOwners\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06owners\x18\x02 \x03(\tR\x06owners\x12\x18\n" +
+	"\aweights\x18\x03 \x03(\x05R\aweights\x124\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x18.taskstoredb.v1.StrategyR\bstrategy\"F\n" +
+	"\x12KindOwnersResponse\x120\n" +
+	"\x05kinds\x18\x01 \x03(\v2\x1a.taskstoredb.v1.KindOwnersR\x05kinds\"\x85\x01\n" +
+	"\tNamespace\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmax_tasks\x18\x02 \x01(\x03R\bmaxTasks\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes\x12\x14\n" +
+	"\x05tasks\x18\x04 \x01(\x03R\x05tasks\x12\x14\n" +
+	"\x05bytes\x18\x05 \x01(\x03R\x05bytes\"*\n" +
+	"\x14NamespaceDropRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"O\n" +
+	"\x12NamespacesResponse\x129\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2\x19.taskstoredb.v1.NamespaceR\n" +
+	"namespaces*q\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06VIRGIN\x10\x01\x12\r\n" +
+	"\tSCHEDULED\x10\x02\x12\r\n" +
+	"\tCOMPLETED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04\x12\r\n" +
+	"\tCANCELLED\x10\x05\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x06*t\n" +
+	"\bStrategy\x12\x18\n" +
+	"\x14STRATEGY_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vROUND_ROBIN\x10\x01\x12\f\n" +
+	"\bWEIGHTED\x10\x02\x12\x15\n" +
+	"\x11LEAST_OUTSTANDING\x10\x03\x12\b\n" +
+	"\x04HASH\x10\x04\x12\x0e\n" +
+	"\n" +
+	"UNASSIGNED\x10\x052\x8f\x0e\n" +
+	"\tTaskStore\x12>\n" +
+	"\x03Add\x12\x1a.taskstoredb.v1.AddRequest\x1a\x1b.taskstoredb.v1.AddResponse\x12>\n" +
+	"\x06Update\x12\x1d.taskstoredb.v1.UpdateRequest\x1a\x15.taskstoredb.v1.Empty\x12>\n" +
+	"\x03Get\x12\x1a.taskstoredb.v1.GetRequest\x1a\x1b.taskstoredb.v1.GetResponse\x12b\n" +
+	"\x0fGetFirstInGroup\x12&.taskstoredb.v1.GetFirstInGroupRequest\x1a'.taskstoredb.v1.GetFirstInGroupResponse\x12;\n" +
+	"\x04Pool\x12\x1b.taskstoredb.v1.PoolRequest\x1a\x14.taskstoredb.v1.Task0\x01\x12C\n" +
+	"\n" +
+	"SearchTask\x12\x1d.taskstoredb.v1.SearchRequest\x1a\x14.taskstoredb.v1.Task0\x01\x12D\n" +
+	"\vSearchError\x12\x1d.taskstoredb.v1.SearchRequest\x1a\x14.taskstoredb.v1.Task0\x01\x12H\n" +
+	"\x10SearchDeleteTask\x12\x1d.taskstoredb.v1.SearchRequest\x1a\x15.taskstoredb.v1.Empty\x12M\n" +
+	"\x15SearchDeleteErrorTask\x12\x1d.taskstoredb.v1.SearchRequest\x1a\x15.taskstoredb.v1.Empty\x12N\n" +
+	"\x10SearchUpdateTask\x12#.taskstoredb.v1.SearchUpdateRequest\x1a\x15.taskstoredb.v1.Empty\x12S\n" +
+	"\x15SearchUpdateErrorTask\x12#.taskstoredb.v1.SearchUpdateRequest\x1a\x15.taskstoredb.v1.Empty\x12B\n" +
+	"\bOwnerReg\x12\x1f.taskstoredb.v1.OwnerRegRequest\x1a\x15.taskstoredb.v1.Empty\x12F\n" +
+	"\n" +
+	"OwnerUnReg\x12!.taskstoredb.v1.OwnerUnRegRequest\x1a\x15.taskstoredb.v1.Empty\x12N\n" +
+	"\x0eOwnerHeartbeat\x12%.taskstoredb.v1.OwnerHeartbeatRequest\x1a\x15.taskstoredb.v1.Empty\x12<\n" +
+	"\x05Pause\x12\x1c.taskstoredb.v1.PauseRequest\x1a\x15.taskstoredb.v1.Empty\x12=\n" +
+	"\x06Resume\x12\x1c.taskstoredb.v1.PauseRequest\x1a\x15.taskstoredb.v1.Empty\x12?\n" +
+	"\x06Paused\x12\x15.taskstoredb.v1.Empty\x1a\x1e.taskstoredb.v1.PausedResponse\x12B\n" +
+	"\rKindConfigSet\x12\x1a.taskstoredb.v1.KindConfig\x1a\x15.taskstoredb.v1.Empty\x12I\n" +
+	"\vKindConfigs\x12\x15.taskstoredb.v1.Empty\x1a#.taskstoredb.v1.KindConfigsResponse\x12G\n" +
+	"\n" +
+	"KindOwners\x12\x15.taskstoredb.v1.Empty\x1a\".taskstoredb.v1.KindOwnersResponse\x12M\n" +
+	"\bInFlight\x12\x1f.taskstoredb.v1.InFlightRequest\x1a .taskstoredb.v1.InFlightResponse\x12;\n" +
+	"\vHealthCheck\x12\x15.taskstoredb.v1.Empty\x1a\x15.taskstoredb.v1.Empty\x12C\n" +
+	"\x0fNamespaceCreate\x12\x19.taskstoredb.v1.Namespace\x1a\x15.taskstoredb.v1.Empty\x12L\n" +
+	"\rNamespaceDrop\x12$.taskstoredb.v1.NamespaceDropRequest\x1a\x15.taskstoredb.v1.Empty\x12G\n" +
+	"\n" +
+	"Namespaces\x12\x15.taskstoredb.v1.Empty\x1a\".taskstoredb.v1.NamespacesResponseB]\n" +
+	"%com.github.esaseleznev.taskstoredb.v1P\x01Z2github.com/esaseleznev/taskstoredb/pkg/taskstorepbb\x06proto3"
+
+var (
+	file_taskstore_v1_taskstore_proto_rawDescOnce sync.Once
+	file_taskstore_v1_taskstore_proto_rawDescData []byte
+)
+
+func file_taskstore_v1_taskstore_proto_rawDescGZIP() []byte {
+	file_taskstore_v1_taskstore_proto_rawDescOnce.Do(func() {
+		file_taskstore_v1_taskstore_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_taskstore_v1_taskstore_proto_rawDesc), len(file_taskstore_v1_taskstore_proto_rawDesc)))
+	})
+	return file_taskstore_v1_taskstore_proto_rawDescData
+}
+
+var file_taskstore_v1_taskstore_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_taskstore_v1_taskstore_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_taskstore_v1_taskstore_proto_goTypes = []any{
+	(Status)(0),                     // 0: taskstoredb.v1.Status
+	(Strategy)(0),                   // 1: taskstoredb.v1.Strategy
+	(*Empty)(nil),                   // 2: taskstoredb.v1.Empty
+	(*Task)(nil),                    // 3: taskstoredb.v1.Task
+	(*TaskUpdate)(nil),              // 4: taskstoredb.v1.TaskUpdate
+	(*Operation)(nil),               // 5: taskstoredb.v1.Operation
+	(*Condition)(nil),               // 6: taskstoredb.v1.Condition
+	(*AddRequest)(nil),              // 7: taskstoredb.v1.AddRequest
+	(*AddResponse)(nil),             // 8: taskstoredb.v1.AddResponse
+	(*UpdateRequest)(nil),           // 9: taskstoredb.v1.UpdateRequest
+	(*GetRequest)(nil),              // 10: taskstoredb.v1.GetRequest
+	(*GetResponse)(nil),             // 11: taskstoredb.v1.GetResponse
+	(*GetFirstInGroupRequest)(nil),  // 12: taskstoredb.v1.GetFirstInGroupRequest
+	(*GetFirstInGroupResponse)(nil), // 13: taskstoredb.v1.GetFirstInGroupResponse
+	(*PoolRequest)(nil),             // 14: taskstoredb.v1.PoolRequest
+	(*SearchRequest)(nil),           // 15: taskstoredb.v1.SearchRequest
+	(*SearchUpdateRequest)(nil),     // 16: taskstoredb.v1.SearchUpdateRequest
+	(*OwnerRegRequest)(nil),         // 17: taskstoredb.v1.OwnerRegRequest
+	(*OwnerHeartbeatRequest)(nil),   // 18: taskstoredb.v1.OwnerHeartbeatRequest
+	(*OwnerUnRegRequest)(nil),       // 19: taskstoredb.v1.OwnerUnRegRequest
+	(*PauseRequest)(nil),            // 20: taskstoredb.v1.PauseRequest
+	(*Pause)(nil),                   // 21: taskstoredb.v1.Pause
+	(*PausedResponse)(nil),          // 22: taskstoredb.v1.PausedResponse
+	(*KindConfig)(nil),              // 23: taskstoredb.v1.KindConfig
+	(*InFlightRequest)(nil),         // 24: taskstoredb.v1.InFlightRequest
+	(*InFlightResponse)(nil),        // 25: taskstoredb.v1.InFlightResponse
+	(*KindConfigsResponse)(nil),     // 26: taskstoredb.v1.KindConfigsResponse
+	(*KindOwners)(nil),              // 27: taskstoredb.v1.KindOwners
+	(*KindOwnersResponse)(nil),      // 28: taskstoredb.v1.KindOwnersResponse
+	(*Namespace)(nil),               // 29: taskstoredb.v1.Namespace
+	(*NamespaceDropRequest)(nil),    // 30: taskstoredb.v1.NamespaceDropRequest
+	(*NamespacesResponse)(nil),      // 31: taskstoredb.v1.NamespacesResponse
+	nil,                             // 32: taskstoredb.v1.Task.ParamEntry
+	nil,                             // 33: taskstoredb.v1.TaskUpdate.ParamEntry
+	nil,                             // 34: taskstoredb.v1.AddRequest.ParamEntry
+	nil,                             // 35: taskstoredb.v1.UpdateRequest.ParamEntry
+	(*timestamppb.Timestamp)(nil),   // 36: google.protobuf.Timestamp
+	(*structpb.Value)(nil),          // 37: google.protobuf.Value
+	(*durationpb.Duration)(nil),     // 38: google.protobuf.Duration
+}
+var file_taskstore_v1_taskstore_proto_depIdxs = []int32{
+	0,  // 0: taskstoredb.v1.Task.status:type_name -> taskstoredb.v1.Status
+	32, // 1: taskstoredb.v1.Task.param:type_name -> taskstoredb.v1.Task.ParamEntry
+	36, // 2: taskstoredb.v1.Task.ts:type_name -> google.protobuf.Timestamp
+	0,  // 3: taskstoredb.v1.TaskUpdate.status:type_name -> taskstoredb.v1.Status
+	33, // 4: taskstoredb.v1.TaskUpdate.param:type_name -> taskstoredb.v1.TaskUpdate.ParamEntry
+	37, // 5: taskstoredb.v1.Operation.value:type_name -> google.protobuf.Value
+	5,  // 6: taskstoredb.v1.Condition.operations:type_name -> taskstoredb.v1.Operation
+	6,  // 7: taskstoredb.v1.Condition.conditions:type_name -> taskstoredb.v1.Condition
+	34, // 8: taskstoredb.v1.AddRequest.param:type_name -> taskstoredb.v1.AddRequest.ParamEntry
+	0,  // 9: taskstoredb.v1.UpdateRequest.status:type_name -> taskstoredb.v1.Status
+	35, // 10: taskstoredb.v1.UpdateRequest.param:type_name -> taskstoredb.v1.UpdateRequest.ParamEntry
+	3,  // 11: taskstoredb.v1.GetResponse.task:type_name -> taskstoredb.v1.Task
+	38, // 12: taskstoredb.v1.PoolRequest.wait:type_name -> google.protobuf.Duration
+	6,  // 13: taskstoredb.v1.SearchRequest.condition:type_name -> taskstoredb.v1.Condition
+	4,  // 14: taskstoredb.v1.SearchUpdateRequest.up:type_name -> taskstoredb.v1.TaskUpdate
+	6,  // 15: taskstoredb.v1.SearchUpdateRequest.condition:type_name -> taskstoredb.v1.Condition
+	38, // 16: taskstoredb.v1.OwnerRegRequest.ttl:type_name -> google.protobuf.Duration
+	38, // 17: taskstoredb.v1.OwnerHeartbeatRequest.ttl:type_name -> google.protobuf.Duration
+	36, // 18: taskstoredb.v1.Pause.ts:type_name -> google.protobuf.Timestamp
+	21, // 19: taskstoredb.v1.PausedResponse.pauses:type_name -> taskstoredb.v1.Pause
+	1,  // 20: taskstoredb.v1.KindConfig.strategy:type_name -> taskstoredb.v1.Strategy
+	23, // 21: taskstoredb.v1.KindConfigsResponse.configs:type_name -> taskstoredb.v1.KindConfig
+	1,  // 22: taskstoredb.v1.KindOwners.strategy:type_name -> taskstoredb.v1.Strategy
+	27, // 23: taskstoredb.v1.KindOwnersResponse.kinds:type_name -> taskstoredb.v1.KindOwners
+	29, // 24: taskstoredb.v1.NamespacesResponse.namespaces:type_name -> taskstoredb.v1.Namespace
+	7,  // 25: taskstoredb.v1.TaskStore.Add:input_type -> taskstoredb.v1.AddRequest
+	9,  // 26: taskstoredb.v1.TaskStore.Update:input_type -> taskstoredb.v1.UpdateRequest
+	10, // 27: taskstoredb.v1.TaskStore.Get:input_type -> taskstoredb.v1.GetRequest
+	12, // 28: taskstoredb.v1.TaskStore.GetFirstInGroup:input_type -> taskstoredb.v1.GetFirstInGroupRequest
+	14, // 29: taskstoredb.v1.TaskStore.Pool:input_type -> taskstoredb.v1.PoolRequest
+	15, // 30: taskstoredb.v1.TaskStore.SearchTask:input_type -> taskstoredb.v1.SearchRequest
+	15, // 31: taskstoredb.v1.TaskStore.SearchError:input_type -> taskstoredb.v1.SearchRequest
+	15, // 32: taskstoredb.v1.TaskStore.SearchDeleteTask:input_type -> taskstoredb.v1.SearchRequest
+	15, // 33: taskstoredb.v1.TaskStore.SearchDeleteErrorTask:input_type -> taskstoredb.v1.SearchRequest
+	16, // 34: taskstoredb.v1.TaskStore.SearchUpdateTask:input_type -> taskstoredb.v1.SearchUpdateRequest
+	16, // 35: taskstoredb.v1.TaskStore.SearchUpdateErrorTask:input_type -> taskstoredb.v1.SearchUpdateRequest
+	17, // 36: taskstoredb.v1.TaskStore.OwnerReg:input_type -> taskstoredb.v1.OwnerRegRequest
+	19, // 37: taskstoredb.v1.TaskStore.OwnerUnReg:input_type -> taskstoredb.v1.OwnerUnRegRequest
+	18, // 38: taskstoredb.v1.TaskStore.OwnerHeartbeat:input_type -> taskstoredb.v1.OwnerHeartbeatRequest
+	20, // 39: taskstoredb.v1.TaskStore.Pause:input_type -> taskstoredb.v1.PauseRequest
+	20, // 40: taskstoredb.v1.TaskStore.Resume:input_type -> taskstoredb.v1.PauseRequest
+	2,  // 41: taskstoredb.v1.TaskStore.Paused:input_type -> taskstoredb.v1.Empty
+	23, // 42: taskstoredb.v1.TaskStore.KindConfigSet:input_type -> taskstoredb.v1.KindConfig
+	2,  // 43: taskstoredb.v1.TaskStore.KindConfigs:input_type -> taskstoredb.v1.Empty
+	2,  // 44: taskstoredb.v1.TaskStore.KindOwners:input_type -> taskstoredb.v1.Empty
+	24, // 45: taskstoredb.v1.TaskStore.InFlight:input_type -> taskstoredb.v1.InFlightRequest
+	2,  // 46: taskstoredb.v1.TaskStore.HealthCheck:input_type -> taskstoredb.v1.Empty
+	29, // 47: taskstoredb.v1.TaskStore.NamespaceCreate:input_type -> taskstoredb.v1.Namespace
+	30, // 48: taskstoredb.v1.TaskStore.NamespaceDrop:input_type -> taskstoredb.v1.NamespaceDropRequest
+	2,  // 49: taskstoredb.v1.TaskStore.Namespaces:input_type -> taskstoredb.v1.Empty
+	8,  // 50: taskstoredb.v1.TaskStore.Add:output_type -> taskstoredb.v1.AddResponse
+	2,  // 51: taskstoredb.v1.TaskStore.Update:output_type -> taskstoredb.v1.Empty
+	11, // 52: taskstoredb.v1.TaskStore.Get:output_type -> taskstoredb.v1.GetResponse
+	13, // 53: taskstoredb.v1.TaskStore.GetFirstInGroup:output_type -> taskstoredb.v1.GetFirstInGroupResponse
+	3,  // 54: taskstoredb.v1.TaskStore.Pool:output_type -> taskstoredb.v1.Task
+	3,  // 55: taskstoredb.v1.TaskStore.SearchTask:output_type -> taskstoredb.v1.Task
+	3,  // 56: taskstoredb.v1.TaskStore.SearchError:output_type -> taskstoredb.v1.Task
+	2,  // 57: taskstoredb.v1.TaskStore.SearchDeleteTask:output_type -> taskstoredb.v1.Empty
+	2,  // 58: taskstoredb.v1.TaskStore.SearchDeleteErrorTask:output_type -> taskstoredb.v1.Empty
+	2,  // 59: taskstoredb.v1.TaskStore.SearchUpdateTask:output_type -> taskstoredb.v1.Empty
+	2,  // 60: taskstoredb.v1.TaskStore.SearchUpdateErrorTask:output_type -> taskstoredb.v1.Empty
+	2,  // 61: taskstoredb.v1.TaskStore.OwnerReg:output_type -> taskstoredb.v1.Empty
+	2,  // 62: taskstoredb.v1.TaskStore.OwnerUnReg:output_type -> taskstoredb.v1.Empty
+	2,  // 63: taskstoredb.v1.TaskStore.OwnerHeartbeat:output_type -> taskstoredb.v1.Empty
+	2,  // 64: taskstoredb.v1.TaskStore.Pause:output_type -> taskstoredb.v1.Empty
+	2,  // 65: taskstoredb.v1.TaskStore.Resume:output_type -> taskstoredb.v1.Empty
+	22, // 66: taskstoredb.v1.TaskStore.Paused:output_type -> taskstoredb.v1.PausedResponse
+	2,  // 67: taskstoredb.v1.TaskStore.KindConfigSet:output_type -> taskstoredb.v1.Empty
+	26, // 68: taskstoredb.v1.TaskStore.KindConfigs:output_type -> taskstoredb.v1.KindConfigsResponse
+	28, // 69: taskstoredb.v1.TaskStore.KindOwners:output_type -> taskstoredb.v1.KindOwnersResponse
+	25, // 70: taskstoredb.v1.TaskStore.InFlight:output_type -> taskstoredb.v1.InFlightResponse
+	2,  // 71: taskstoredb.v1.TaskStore.HealthCheck:output_type -> taskstoredb.v1.Empty
+	2,  // 72: taskstoredb.v1.TaskStore.NamespaceCreate:output_type -> taskstoredb.v1.Empty
+	2,  // 73: taskstoredb.v1.TaskStore.NamespaceDrop:output_type -> taskstoredb.v1.Empty
+	31, // 74: taskstoredb.v1.TaskStore.Namespaces:output_type -> taskstoredb.v1.NamespacesResponse
+	50, // [50:75] is the sub-list for method output_type
+	25, // [25:50] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_taskstore_v1_taskstore_proto_init() }
+func file_taskstore_v1_taskstore_proto_init() {
+	if File_taskstore_v1_taskstore_proto != nil {
+		return
+	}
+	file_taskstore_v1_taskstore_proto_msgTypes[1].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[2].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[4].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[5].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[7].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[9].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[13].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[14].OneofWrappers = []any{}
+	file_taskstore_v1_taskstore_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskstore_v1_taskstore_proto_rawDesc), len(file_taskstore_v1_taskstore_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_taskstore_v1_taskstore_proto_goTypes,
+		DependencyIndexes: file_taskstore_v1_taskstore_proto_depIdxs,
+		EnumInfos:         file_taskstore_v1_taskstore_proto_enumTypes,
+		MessageInfos:      file_taskstore_v1_taskstore_proto_msgTypes,
+	}.Build()
+	File_taskstore_v1_taskstore_proto = out.File
+	file_taskstore_v1_taskstore_proto_goTypes = nil
+	file_taskstore_v1_taskstore_proto_depIdxs = nil
+}
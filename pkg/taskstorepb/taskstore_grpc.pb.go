@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: taskstore/v1/taskstore.proto
+
+package taskstorepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TaskStore_Add_FullMethodName                   = "/taskstoredb.v1.TaskStore/Add"
+	TaskStore_Update_FullMethodName                = "/taskstoredb.v1.TaskStore/Update"
+	TaskStore_Get_FullMethodName                   = "/taskstoredb.v1.TaskStore/Get"
+	TaskStore_GetFirstInGroup_FullMethodName       = "/taskstoredb.v1.TaskStore/GetFirstInGroup"
+	TaskStore_Pool_FullMethodName                  = "/taskstoredb.v1.TaskStore/Pool"
+	TaskStore_SearchTask_FullMethodName            = "/taskstoredb.v1.TaskStore/SearchTask"
+	TaskStore_SearchError_FullMethodName           = "/taskstoredb.v1.TaskStore/SearchError"
+	TaskStore_SearchDeleteTask_FullMethodName      = "/taskstoredb.v1.TaskStore/SearchDeleteTask"
+	TaskStore_SearchDeleteErrorTask_FullMethodName = "/taskstoredb.v1.TaskStore/SearchDeleteErrorTask"
+	TaskStore_SearchUpdateTask_FullMethodName      = "/taskstoredb.v1.TaskStore/SearchUpdateTask"
+	TaskStore_SearchUpdateErrorTask_FullMethodName = "/taskstoredb.v1.TaskStore/SearchUpdateErrorTask"
+	TaskStore_OwnerReg_FullMethodName              = "/taskstoredb.v1.TaskStore/OwnerReg"
+	TaskStore_OwnerUnReg_FullMethodName            = "/taskstoredb.v1.TaskStore/OwnerUnReg"
+	TaskStore_OwnerHeartbeat_FullMethodName        = "/taskstoredb.v1.TaskStore/OwnerHeartbeat"
+	TaskStore_Pause_FullMethodName                 = "/taskstoredb.v1.TaskStore/Pause"
+	TaskStore_Resume_FullMethodName                = "/taskstoredb.v1.TaskStore/Resume"
+	TaskStore_Paused_FullMethodName                = "/taskstoredb.v1.TaskStore/Paused"
+	TaskStore_KindConfigSet_FullMethodName         = "/taskstoredb.v1.TaskStore/KindConfigSet"
+	TaskStore_KindConfigs_FullMethodName           = "/taskstoredb.v1.TaskStore/KindConfigs"
+	TaskStore_KindOwners_FullMethodName            = "/taskstoredb.v1.TaskStore/KindOwners"
+	TaskStore_InFlight_FullMethodName              = "/taskstoredb.v1.TaskStore/InFlight"
+	TaskStore_HealthCheck_FullMethodName           = "/taskstoredb.v1.TaskStore/HealthCheck"
+	TaskStore_NamespaceCreate_FullMethodName       = "/taskstoredb.v1.TaskStore/NamespaceCreate"
+	TaskStore_NamespaceDrop_FullMethodName         = "/taskstoredb.v1.TaskStore/NamespaceDrop"
+	TaskStore_Namespaces_FullMethodName            = "/taskstoredb.v1.TaskStore/Namespaces"
+)
+
+// TaskStoreClient is the client API for TaskStore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TaskStore is the public api, the same commands and queries as the http port.
+// A call is served in the namespace of its x-namespace metadata, the default
+// namespace without it.
+type TaskStoreClient interface {
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	GetFirstInGroup(ctx context.Context, in *GetFirstInGroupRequest, opts ...grpc.CallOption) (*GetFirstInGroupResponse, error)
+	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Task], error)
+	SearchTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Task], error)
+	SearchError(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Task], error)
+	SearchDeleteTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error)
+	SearchDeleteErrorTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error)
+	SearchUpdateTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	SearchUpdateErrorTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	OwnerReg(ctx context.Context, in *OwnerRegRequest, opts ...grpc.CallOption) (*Empty, error)
+	OwnerUnReg(ctx context.Context, in *OwnerUnRegRequest, opts ...grpc.CallOption) (*Empty, error)
+	OwnerHeartbeat(ctx context.Context, in *OwnerHeartbeatRequest, opts ...grpc.CallOption) (*Empty, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Empty, error)
+	Resume(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Empty, error)
+	Paused(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PausedResponse, error)
+	KindConfigSet(ctx context.Context, in *KindConfig, opts ...grpc.CallOption) (*Empty, error)
+	KindConfigs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KindConfigsResponse, error)
+	KindOwners(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KindOwnersResponse, error)
+	InFlight(ctx context.Context, in *InFlightRequest, opts ...grpc.CallOption) (*InFlightResponse, error)
+	HealthCheck(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// creates a namespace or changes its quotas
+	NamespaceCreate(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Empty, error)
+	// drops a namespace with all its tasks
+	NamespaceDrop(ctx context.Context, in *NamespaceDropRequest, opts ...grpc.CallOption) (*Empty, error)
+	Namespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error)
+}
+
+type taskStoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskStoreClient(cc grpc.ClientConnInterface) TaskStoreClient {
+	return &taskStoreClient{cc}
+}
+
+func (c *taskStoreClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, TaskStore_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, TaskStore_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) GetFirstInGroup(ctx context.Context, in *GetFirstInGroupRequest, opts ...grpc.CallOption) (*GetFirstInGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFirstInGroupResponse)
+	err := c.cc.Invoke(ctx, TaskStore_GetFirstInGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Task], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskStore_ServiceDesc.Streams[0], TaskStore_Pool_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PoolRequest, Task]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskStore_PoolClient = grpc.ServerStreamingClient[Task]
+
+func (c *taskStoreClient) SearchTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Task], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskStore_ServiceDesc.Streams[1], TaskStore_SearchTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchRequest, Task]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskStore_SearchTaskClient = grpc.ServerStreamingClient[Task]
+
+func (c *taskStoreClient) SearchError(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Task], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskStore_ServiceDesc.Streams[2], TaskStore_SearchError_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchRequest, Task]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskStore_SearchErrorClient = grpc.ServerStreamingClient[Task]
+
+func (c *taskStoreClient) SearchDeleteTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_SearchDeleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) SearchDeleteErrorTask(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_SearchDeleteErrorTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) SearchUpdateTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_SearchUpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) SearchUpdateErrorTask(ctx context.Context, in *SearchUpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_SearchUpdateErrorTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) OwnerReg(ctx context.Context, in *OwnerRegRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_OwnerReg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) OwnerUnReg(ctx context.Context, in *OwnerUnRegRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_OwnerUnReg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) OwnerHeartbeat(ctx context.Context, in *OwnerHeartbeatRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_OwnerHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Resume(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_Resume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Paused(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PausedResponse)
+	err := c.cc.Invoke(ctx, TaskStore_Paused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) KindConfigSet(ctx context.Context, in *KindConfig, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_KindConfigSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) KindConfigs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KindConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KindConfigsResponse)
+	err := c.cc.Invoke(ctx, TaskStore_KindConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) KindOwners(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KindOwnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KindOwnersResponse)
+	err := c.cc.Invoke(ctx, TaskStore_KindOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) InFlight(ctx context.Context, in *InFlightRequest, opts ...grpc.CallOption) (*InFlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InFlightResponse)
+	err := c.cc.Invoke(ctx, TaskStore_InFlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) HealthCheck(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) NamespaceCreate(ctx context.Context, in *Namespace, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_NamespaceCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) NamespaceDrop(ctx context.Context, in *NamespaceDropRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TaskStore_NamespaceDrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskStoreClient) Namespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespacesResponse)
+	err := c.cc.Invoke(ctx, TaskStore_Namespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskStoreServer is the server API for TaskStore service.
+// All implementations must embed UnimplementedTaskStoreServer
+// for forward compatibility.
+//
+// TaskStore is the public api, the same commands and queries as the http port.
+// A call is served in the namespace of its x-namespace metadata, the default
+// namespace without it.
+type TaskStoreServer interface {
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	Update(context.Context, *UpdateRequest) (*Empty, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	GetFirstInGroup(context.Context, *GetFirstInGroupRequest) (*GetFirstInGroupResponse, error)
+	Pool(*PoolRequest, grpc.ServerStreamingServer[Task]) error
+	SearchTask(*SearchRequest, grpc.ServerStreamingServer[Task]) error
+	SearchError(*SearchRequest, grpc.ServerStreamingServer[Task]) error
+	SearchDeleteTask(context.Context, *SearchRequest) (*Empty, error)
+	SearchDeleteErrorTask(context.Context, *SearchRequest) (*Empty, error)
+	SearchUpdateTask(context.Context, *SearchUpdateRequest) (*Empty, error)
+	SearchUpdateErrorTask(context.Context, *SearchUpdateRequest) (*Empty, error)
+	OwnerReg(context.Context, *OwnerRegRequest) (*Empty, error)
+	OwnerUnReg(context.Context, *OwnerUnRegRequest) (*Empty, error)
+	OwnerHeartbeat(context.Context, *OwnerHeartbeatRequest) (*Empty, error)
+	Pause(context.Context, *PauseRequest) (*Empty, error)
+	Resume(context.Context, *PauseRequest) (*Empty, error)
+	Paused(context.Context, *Empty) (*PausedResponse, error)
+	KindConfigSet(context.Context, *KindConfig) (*Empty, error)
+	KindConfigs(context.Context, *Empty) (*KindConfigsResponse, error)
+	KindOwners(context.Context, *Empty) (*KindOwnersResponse, error)
+	InFlight(context.Context, *InFlightRequest) (*InFlightResponse, error)
+	HealthCheck(context.Context, *Empty) (*Empty, error)
+	// creates a namespace or changes its quotas
+	NamespaceCreate(context.Context, *Namespace) (*Empty, error)
+	// drops a namespace with all its tasks
+	NamespaceDrop(context.Context, *NamespaceDropRequest) (*Empty, error)
+	Namespaces(context.Context, *Empty) (*NamespacesResponse, error)
+	mustEmbedUnimplementedTaskStoreServer()
+}
+
+// UnimplementedTaskStoreServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskStoreServer struct{}
+
+func (UnimplementedTaskStoreServer) Add(context.Context, *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedTaskStoreServer) Update(context.Context, *UpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskStoreServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskStoreServer) GetFirstInGroup(context.Context, *GetFirstInGroupRequest) (*GetFirstInGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFirstInGroup not implemented")
+}
+func (UnimplementedTaskStoreServer) Pool(*PoolRequest, grpc.ServerStreamingServer[Task]) error {
+	return status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (UnimplementedTaskStoreServer) SearchTask(*SearchRequest, grpc.ServerStreamingServer[Task]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchTask not implemented")
+}
+func (UnimplementedTaskStoreServer) SearchError(*SearchRequest, grpc.ServerStreamingServer[Task]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchError not implemented")
+}
+func (UnimplementedTaskStoreServer) SearchDeleteTask(context.Context, *SearchRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDeleteTask not implemented")
+}
+func (UnimplementedTaskStoreServer) SearchDeleteErrorTask(context.Context, *SearchRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDeleteErrorTask not implemented")
+}
+func (UnimplementedTaskStoreServer) SearchUpdateTask(context.Context, *SearchUpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUpdateTask not implemented")
+}
+func (UnimplementedTaskStoreServer) SearchUpdateErrorTask(context.Context, *SearchUpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUpdateErrorTask not implemented")
+}
+func (UnimplementedTaskStoreServer) OwnerReg(context.Context, *OwnerRegRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerReg not implemented")
+}
+func (UnimplementedTaskStoreServer) OwnerUnReg(context.Context, *OwnerUnRegRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerUnReg not implemented")
+}
+func (UnimplementedTaskStoreServer) OwnerHeartbeat(context.Context, *OwnerHeartbeatRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerHeartbeat not implemented")
+}
+func (UnimplementedTaskStoreServer) Pause(context.Context, *PauseRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedTaskStoreServer) Resume(context.Context, *PauseRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedTaskStoreServer) Paused(context.Context, *Empty) (*PausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (UnimplementedTaskStoreServer) KindConfigSet(context.Context, *KindConfig) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KindConfigSet not implemented")
+}
+func (UnimplementedTaskStoreServer) KindConfigs(context.Context, *Empty) (*KindConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KindConfigs not implemented")
+}
+func (UnimplementedTaskStoreServer) KindOwners(context.Context, *Empty) (*KindOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KindOwners not implemented")
+}
+func (UnimplementedTaskStoreServer) InFlight(context.Context, *InFlightRequest) (*InFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlight not implemented")
+}
+func (UnimplementedTaskStoreServer) HealthCheck(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedTaskStoreServer) NamespaceCreate(context.Context, *Namespace) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceCreate not implemented")
+}
+func (UnimplementedTaskStoreServer) NamespaceDrop(context.Context, *NamespaceDropRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceDrop not implemented")
+}
+func (UnimplementedTaskStoreServer) Namespaces(context.Context, *Empty) (*NamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespaces not implemented")
+}
+func (UnimplementedTaskStoreServer) mustEmbedUnimplementedTaskStoreServer() {}
+func (UnimplementedTaskStoreServer) testEmbeddedByValue()                   {}
+
+// UnsafeTaskStoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskStoreServer will
+// result in compilation errors.
+type UnsafeTaskStoreServer interface {
+	mustEmbedUnimplementedTaskStoreServer()
+}
+
+func RegisterTaskStoreServer(s grpc.ServiceRegistrar, srv TaskStoreServer) {
+	// If the following call pancis, it indicates UnimplementedTaskStoreServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskStore_ServiceDesc, srv)
+}
+
+func _TaskStore_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_GetFirstInGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFirstInGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).GetFirstInGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_GetFirstInGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).GetFirstInGroup(ctx, req.(*GetFirstInGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Pool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PoolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskStoreServer).Pool(m, &grpc.GenericServerStream[PoolRequest, Task]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskStore_PoolServer = grpc.ServerStreamingServer[Task]
+
+func _TaskStore_SearchTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskStoreServer).SearchTask(m, &grpc.GenericServerStream[SearchRequest, Task]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskStore_SearchTaskServer = grpc.ServerStreamingServer[Task]
+
+func _TaskStore_SearchError_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskStoreServer).SearchError(m, &grpc.GenericServerStream[SearchRequest, Task]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskStore_SearchErrorServer = grpc.ServerStreamingServer[Task]
+
+func _TaskStore_SearchDeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).SearchDeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_SearchDeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).SearchDeleteTask(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_SearchDeleteErrorTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).SearchDeleteErrorTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_SearchDeleteErrorTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).SearchDeleteErrorTask(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_SearchUpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).SearchUpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_SearchUpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).SearchUpdateTask(ctx, req.(*SearchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_SearchUpdateErrorTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).SearchUpdateErrorTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_SearchUpdateErrorTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).SearchUpdateErrorTask(ctx, req.(*SearchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_OwnerReg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerRegRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).OwnerReg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_OwnerReg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).OwnerReg(ctx, req.(*OwnerRegRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_OwnerUnReg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerUnRegRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).OwnerUnReg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_OwnerUnReg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).OwnerUnReg(ctx, req.(*OwnerUnRegRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_OwnerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).OwnerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_OwnerHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).OwnerHeartbeat(ctx, req.(*OwnerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Resume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Resume(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Paused(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_KindConfigSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KindConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).KindConfigSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_KindConfigSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).KindConfigSet(ctx, req.(*KindConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_KindConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).KindConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_KindConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).KindConfigs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_KindOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).KindOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_KindOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).KindOwners(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_InFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).InFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_InFlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).InFlight(ctx, req.(*InFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).HealthCheck(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_NamespaceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Namespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).NamespaceCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_NamespaceCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).NamespaceCreate(ctx, req.(*Namespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_NamespaceDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).NamespaceDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_NamespaceDrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).NamespaceDrop(ctx, req.(*NamespaceDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskStore_Namespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskStoreServer).Namespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskStore_Namespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskStoreServer).Namespaces(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskStore_ServiceDesc is the grpc.ServiceDesc for TaskStore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskStore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taskstoredb.v1.TaskStore",
+	HandlerType: (*TaskStoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _TaskStore_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskStore_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskStore_Get_Handler,
+		},
+		{
+			MethodName: "GetFirstInGroup",
+			Handler:    _TaskStore_GetFirstInGroup_Handler,
+		},
+		{
+			MethodName: "SearchDeleteTask",
+			Handler:    _TaskStore_SearchDeleteTask_Handler,
+		},
+		{
+			MethodName: "SearchDeleteErrorTask",
+			Handler:    _TaskStore_SearchDeleteErrorTask_Handler,
+		},
+		{
+			MethodName: "SearchUpdateTask",
+			Handler:    _TaskStore_SearchUpdateTask_Handler,
+		},
+		{
+			MethodName: "SearchUpdateErrorTask",
+			Handler:    _TaskStore_SearchUpdateErrorTask_Handler,
+		},
+		{
+			MethodName: "OwnerReg",
+			Handler:    _TaskStore_OwnerReg_Handler,
+		},
+		{
+			MethodName: "OwnerUnReg",
+			Handler:    _TaskStore_OwnerUnReg_Handler,
+		},
+		{
+			MethodName: "OwnerHeartbeat",
+			Handler:    _TaskStore_OwnerHeartbeat_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _TaskStore_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _TaskStore_Resume_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _TaskStore_Paused_Handler,
+		},
+		{
+			MethodName: "KindConfigSet",
+			Handler:    _TaskStore_KindConfigSet_Handler,
+		},
+		{
+			MethodName: "KindConfigs",
+			Handler:    _TaskStore_KindConfigs_Handler,
+		},
+		{
+			MethodName: "KindOwners",
+			Handler:    _TaskStore_KindOwners_Handler,
+		},
+		{
+			MethodName: "InFlight",
+			Handler:    _TaskStore_InFlight_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _TaskStore_HealthCheck_Handler,
+		},
+		{
+			MethodName: "NamespaceCreate",
+			Handler:    _TaskStore_NamespaceCreate_Handler,
+		},
+		{
+			MethodName: "NamespaceDrop",
+			Handler:    _TaskStore_NamespaceDrop_Handler,
+		},
+		{
+			MethodName: "Namespaces",
+			Handler:    _TaskStore_Namespaces_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Pool",
+			Handler:       _TaskStore_Pool_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchTask",
+			Handler:       _TaskStore_SearchTask_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchError",
+			Handler:       _TaskStore_SearchError_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "taskstore/v1/taskstore.proto",
+}